@@ -39,10 +39,12 @@ type AWSClient struct {
 	partition                 endpoints.Partition
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	s3UsePathStyle            bool                // From provider configuration.
+	s3USEast1RegionalEndpoint string              // From provider configuration.
+	serviceMaxRetries         map[string]int      // From provider configuration.
+	skipTagsReadServices      map[string]struct{} // From provider configuration.
+	stsRegion                 string              // From provider configuration.
+	terraformVersion          string              // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -196,6 +198,12 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// SkipTagsRead returns whether resource tags for the specified service package are not read on refresh.
+func (c *AWSClient) SkipTagsRead(_ context.Context, servicePackageName string) bool {
+	_, ok := c.skipTagsReadServices[servicePackageName]
+	return ok
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
 	c.httpClient = httpClient
@@ -338,6 +346,12 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if maxRetries, ok := c.serviceMaxRetries[servicePackageName]; ok {
+		// API clients apply RetryMaxAttempts on top of the configured Retryer.
+		cfg := c.awsConfig.Copy()
+		cfg.RetryMaxAttempts = maxRetries
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
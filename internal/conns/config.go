@@ -46,6 +46,8 @@ type Config struct {
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
+	ServiceMaxRetries              map[string]int
+	SkipTagsReadServices           map[string]struct{}
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -203,6 +205,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceMaxRetries = c.ServiceMaxRetries
+	client.skipTagsReadServices = c.SkipTagsReadServices
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -266,6 +266,10 @@ func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) SkipTagsRead(context.Context, string) bool {
+	return false
+}
+
 func (c mockClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	panic("not implemented") //lintignore:R009
 }
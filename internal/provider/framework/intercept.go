@@ -29,6 +29,7 @@ type awsClient interface {
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
+	SkipTagsRead(ctx context.Context, servicePackageName string) bool
 }
 
 type interceptorOptions[Request, Response any] struct {
@@ -169,6 +169,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of times an AWS API request is being executed for specific services,\nkeyed by service (the same keys as the `endpoints` block). Overrides `max_retries` for those services.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -195,6 +200,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tags_read_services": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Services for which resource tags are not read from the service API on refresh,\nkeyed by service (the same keys as the `endpoints` block). Tags are kept from state.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
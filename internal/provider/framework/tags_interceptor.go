@@ -135,6 +135,11 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 			return
 		}
 
+		// If tag reads are skipped for the service, keep tags and tags_all from state.
+		if tagsInContext.TagsOut.IsNone() && c.SkipTagsRead(ctx, sp.ServicePackageName()) {
+			return
+		}
+
 		// If the R handler didn't set tags, try and read them from the service API.
 		if tagsInContext.TagsOut.IsNone() {
 			// Some old resources may not have the required attribute set after Read:
@@ -27,6 +27,7 @@ type awsClient interface {
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
+	SkipTagsRead(ctx context.Context, servicePackageName string) bool
 }
 
 // schemaResourceData is an interface that implements a subset of schema.ResourceData's public methods.
@@ -206,6 +206,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_max_retries": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Description: "The maximum number of times an AWS API request is being executed for specific services,\n" +
+						"keyed by service (the same keys as the `endpoints` block). Overrides `max_retries` for those services.",
+					Elem: &schema.Schema{Type: schema.TypeInt},
+				},
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -243,6 +250,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Skip requesting the account ID. " +
 						"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 				},
+				"skip_tags_read_services": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Description: "Services for which resource tags are not read from the service API on refresh,\n" +
+						"keyed by service (the same keys as the `endpoints` block). Tags are kept from state.",
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
 				"sts_region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -469,6 +483,24 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_max_retries"); ok && len(v.(map[string]any)) > 0 {
+		serviceMaxRetries, dx := expandServiceMaxRetries(ctx, v.(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceMaxRetries = serviceMaxRetries
+	}
+
+	if v, ok := d.GetOk("skip_tags_read_services"); ok && v.(*schema.Set).Len() > 0 {
+		skipTagsReadServices, dx := expandSkipTagsReadServices(ctx, v.(*schema.Set).List())
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.SkipTagsReadServices = skipTagsReadServices
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -1154,3 +1186,67 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	return ignoreConfig
 }
+
+// expandServiceMaxRetries returns the per-service maximum number of attempts, keyed by service package name.
+// Keys may be any of the service aliases accepted in the `endpoints` block.
+func expandServiceMaxRetries(_ context.Context, tfMap map[string]any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrPath := cty.GetAttrPath("service_max_retries")
+	serviceMaxRetries := make(map[string]int)
+
+	for k, v := range tfMap {
+		elementPath := attrPath.IndexString(k)
+
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q. Use the same keys as the \"endpoints\" block.", k),
+			))
+			continue
+		}
+
+		maxRetries := v.(int)
+		if maxRetries < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Expected %q to be at least 1, got %d.", k, maxRetries),
+			))
+			continue
+		}
+
+		serviceMaxRetries[pkg] = maxRetries
+	}
+
+	return serviceMaxRetries, diags
+}
+
+// expandSkipTagsReadServices returns the set of service package names for which tags are not read on refresh.
+// Values may be any of the service aliases accepted in the `endpoints` block.
+func expandSkipTagsReadServices(_ context.Context, tfList []any) (map[string]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrPath := cty.GetAttrPath("skip_tags_read_services")
+	skipTagsReadServices := make(map[string]struct{})
+
+	for _, v := range tfList {
+		v := v.(string)
+
+		pkg, err := names.ProviderPackageForAlias(v)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				attrPath,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q. Use the same keys as the \"endpoints\" block.", v),
+			))
+			continue
+		}
+
+		skipTagsReadServices[pkg] = struct{}{}
+	}
+
+	return skipTagsReadServices, diags
+}
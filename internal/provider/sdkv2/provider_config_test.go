@@ -621,3 +621,98 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 		})
 	}
 }
+
+func TestProviderConfig_AssumeRoleWithWebIdentity(t *testing.T) { //nolint:paralleltest
+	testCases := map[string]struct {
+		Config                   map[string]any
+		ExpectedCredentialsValue aws.Credentials
+		ExpectedDiags            diag.Diagnostics
+		MockStsEndpoints         []*servicemocks.MockEndpoint
+	}{
+		"config": {
+			Config: map[string]any{
+				"assume_role_with_web_identity": []any{
+					map[string]any{
+						"role_arn":           servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+						"session_name":       servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+						"web_identity_token": servicemocks.MockWebIdentityToken,
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleWithWebIdentityCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			},
+		},
+
+		"config chained with assume_role": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+				"assume_role_with_web_identity": []any{
+					map[string]any{
+						"role_arn":           servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+						"session_name":       servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+						"web_identity_token": servicemocks.MockWebIdentityToken,
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+		},
+	}
+
+	for name, tc := range testCases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			closeSts, _, stsEndpoint := mockdata.GetMockedAwsApiSession("STS", tc.MockStsEndpoints)
+			defer closeSts()
+
+			config := map[string]any{
+				"region":                      "us-west-2", //lintignore:AWSAT003
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"endpoints": []any{
+					map[string]any{
+						"sts": stsEndpoint,
+					},
+				},
+			}
+
+			maps.Copy(config, tc.Config)
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := NewProvider(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.TerraformVersion = "1.0.0"
+
+			var diags diag.Diagnostics
+			diags = append(diags, p.Validate(rc)...)
+			if diags.HasError() {
+				t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			diags = append(diags, p.Configure(ctx, rc)...)
+
+			expectedDiags := tc.ExpectedDiags
+
+			if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -22,2339 +23,2643 @@ func endpointsSchema() *schema.Schema {
 				// accessanalyzer
 
 				"accessanalyzer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// account
 
 				"account": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// acm
 
 				"acm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// acmpca
 
 				"acmpca": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// amp
 
 				"amp": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"prometheus": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"prometheusservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// amplify
 
 				"amplify": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// apigateway
 
 				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// apigatewayv2
 
 				"apigatewayv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appautoscaling
 
 				"appautoscaling": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"applicationautoscaling": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appconfig
 
 				"appconfig": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appfabric
 
 				"appfabric": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appflow
 
 				"appflow": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appintegrations
 
 				"appintegrations": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"appintegrationsservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// applicationinsights
 
 				"applicationinsights": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// applicationsignals
 
 				"applicationsignals": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appmesh
 
 				"appmesh": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// apprunner
 
 				"apprunner": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appstream
 
 				"appstream": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// appsync
 
 				"appsync": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// arcregionswitch
 
 				"arcregionswitch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// athena
 
 				"athena": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// auditmanager
 
 				"auditmanager": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// autoscaling
 
 				"autoscaling": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// autoscalingplans
 
 				"autoscalingplans": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// backup
 
 				"backup": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// batch
 
 				"batch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// bcmdataexports
 
 				"bcmdataexports": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// bedrock
 
 				"bedrock": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// bedrockagent
 
 				"bedrockagent": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// bedrockagentcore
 
 				"bedrockagentcore": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// billing
 
 				"billing": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// budgets
 
 				"budgets": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ce
 
 				"ce": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"costexplorer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// chatbot
 
 				"chatbot": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// chime
 
 				"chime": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// chimesdkmediapipelines
 
 				"chimesdkmediapipelines": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// chimesdkvoice
 
 				"chimesdkvoice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cleanrooms
 
 				"cleanrooms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloud9
 
 				"cloud9": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudcontrol
 
 				"cloudcontrol": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudcontrolapi": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudformation
 
 				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudfront
 
 				"cloudfront": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudfrontkeyvaluestore
 
 				"cloudfrontkeyvaluestore": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudhsmv2
 
 				"cloudhsmv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudhsm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudsearch
 
 				"cloudsearch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudtrail
 
 				"cloudtrail": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cloudwatch
 
 				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codeartifact
 
 				"codeartifact": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codebuild
 
 				"codebuild": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codecatalyst
 
 				"codecatalyst": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codecommit
 
 				"codecommit": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codeconnections
 
 				"codeconnections": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codeguruprofiler
 
 				"codeguruprofiler": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codegurureviewer
 
 				"codegurureviewer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codepipeline
 
 				"codepipeline": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codestarconnections
 
 				"codestarconnections": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// codestarnotifications
 
 				"codestarnotifications": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cognitoidentity
 
 				"cognitoidentity": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cognitoidp
 
 				"cognitoidp": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cognitoidentityprovider": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// comprehend
 
 				"comprehend": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// computeoptimizer
 
 				"computeoptimizer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// configservice
 
 				"configservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"config": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// connect
 
 				"connect": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// connectcases
 
 				"connectcases": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// controltower
 
 				"controltower": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// costoptimizationhub
 
 				"costoptimizationhub": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// cur
 
 				"cur": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"costandusagereportservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// customerprofiles
 
 				"customerprofiles": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// databrew
 
 				"databrew": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"gluedatabrew": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dataexchange
 
 				"dataexchange": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// datapipeline
 
 				"datapipeline": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// datasync
 
 				"datasync": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// datazone
 
 				"datazone": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dax
 
 				"dax": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// deploy
 
 				"deploy": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"codedeploy": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// detective
 
 				"detective": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// devicefarm
 
 				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// devopsguru
 
 				"devopsguru": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// directconnect
 
 				"directconnect": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dlm
 
 				"dlm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dms
 
 				"dms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"databasemigration": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"databasemigrationservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// docdb
 
 				"docdb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// docdbelastic
 
 				"docdbelastic": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// drs
 
 				"drs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ds
 
 				"ds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"directoryservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dsql
 
 				"dsql": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// dynamodb
 
 				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ec2
 
 				"ec2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ecr
 
 				"ecr": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ecrpublic
 
 				"ecrpublic": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ecs
 
 				"ecs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// efs
 
 				"efs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// eks
 
 				"eks": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elasticache
 
 				"elasticache": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elasticbeanstalk
 
 				"elasticbeanstalk": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"beanstalk": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elasticsearch
 
 				"elasticsearch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"es": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"elasticsearchservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elastictranscoder
 
 				"elastictranscoder": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elb
 
 				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"elasticloadbalancing": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// elbv2
 
 				"elbv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"elasticloadbalancingv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// emr
 
 				"emr": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// emrcontainers
 
 				"emrcontainers": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// emrserverless
 
 				"emrserverless": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// events
 
 				"events": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"eventbridge": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// evidently
 
 				"evidently": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchevidently": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// evs
 
 				"evs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// finspace
 
 				"finspace": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// firehose
 
 				"firehose": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// fis
 
 				"fis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// fms
 
 				"fms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// fsx
 
 				"fsx": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// gamelift
 
 				"gamelift": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// glacier
 
 				"glacier": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// globalaccelerator
 
 				"globalaccelerator": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// glue
 
 				"glue": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// grafana
 
 				"grafana": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"managedgrafana": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"amg": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// greengrass
 
 				"greengrass": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// groundstation
 
 				"groundstation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// guardduty
 
 				"guardduty": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// healthlake
 
 				"healthlake": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// iam
 
 				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// identitystore
 
 				"identitystore": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// imagebuilder
 
 				"imagebuilder": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// inspector
 
 				"inspector": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// inspector2
 
 				"inspector2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"inspectorv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// internetmonitor
 
 				"internetmonitor": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// invoicing
 
 				"invoicing": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// iot
 
 				"iot": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ivs
 
 				"ivs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ivschat
 
 				"ivschat": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kafka
 
 				"kafka": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"msk": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kafkaconnect
 
 				"kafkaconnect": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kendra
 
 				"kendra": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// keyspaces
 
 				"keyspaces": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kinesis
 
 				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kinesisanalytics
 
 				"kinesisanalytics": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kinesisanalyticsv2
 
 				"kinesisanalyticsv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kinesisvideo
 
 				"kinesisvideo": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// kms
 
 				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lakeformation
 
 				"lakeformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lambda
 
 				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// launchwizard
 
 				"launchwizard": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lexmodels
 
 				"lexmodels": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"lexmodelbuilding": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"lexmodelbuildingservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"lex": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lexv2models
 
 				"lexv2models": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"lexmodelsv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// licensemanager
 
 				"licensemanager": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lightsail
 
 				"lightsail": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// location
 
 				"location": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"locationservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// logs
 
 				"logs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchlog": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// lookoutmetrics
 
 				"lookoutmetrics": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// m2
 
 				"m2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// macie2
 
 				"macie2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediaconnect
 
 				"mediaconnect": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediaconvert
 
 				"mediaconvert": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// medialive
 
 				"medialive": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediapackage
 
 				"mediapackage": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediapackagev2
 
 				"mediapackagev2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediapackagevod
 
 				"mediapackagevod": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mediastore
 
 				"mediastore": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// memorydb
 
 				"memorydb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mgn
 
 				"mgn": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mq
 
 				"mq": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// mwaa
 
 				"mwaa": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// neptune
 
 				"neptune": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// neptunegraph
 
 				"neptunegraph": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// networkfirewall
 
 				"networkfirewall": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// networkflowmonitor
 
 				"networkflowmonitor": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// networkmanager
 
 				"networkmanager": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// networkmonitor
 
 				"networkmonitor": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// notifications
 
 				"notifications": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// notificationscontacts
 
 				"notificationscontacts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// oam
 
 				"oam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchobservabilityaccessmanager": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// observabilityadmin
 
 				"observabilityadmin": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// odb
 
 				"odb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// opensearch
 
 				"opensearch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"opensearchservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// opensearchserverless
 
 				"opensearchserverless": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// organizations
 
 				"organizations": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// osis
 
 				"osis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"opensearchingestion": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// outposts
 
 				"outposts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// paymentcryptography
 
 				"paymentcryptography": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pcaconnectorad
 
 				"pcaconnectorad": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pcs
 
 				"pcs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pinpoint
 
 				"pinpoint": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pinpointsmsvoicev2
 
 				"pinpointsmsvoicev2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pipes
 
 				"pipes": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// polly
 
 				"polly": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// pricing
 
 				"pricing": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// qbusiness
 
 				"qbusiness": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// qldb
 
 				"qldb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// quicksight
 
 				"quicksight": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ram
 
 				"ram": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// rbin
 
 				"rbin": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"recyclebin": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// rds
 
 				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// redshift
 
 				"redshift": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// redshiftdata
 
 				"redshiftdata": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"redshiftdataapiservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// redshiftserverless
 
 				"redshiftserverless": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// rekognition
 
 				"rekognition": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// resiliencehub
 
 				"resiliencehub": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// resourceexplorer2
 
 				"resourceexplorer2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// resourcegroups
 
 				"resourcegroups": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// resourcegroupstaggingapi
 
 				"resourcegroupstaggingapi": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"resourcegroupstagging": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// rolesanywhere
 
 				"rolesanywhere": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53
 
 				"route53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53domains
 
 				"route53domains": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53profiles
 
 				"route53profiles": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53recoverycontrolconfig
 
 				"route53recoverycontrolconfig": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53recoveryreadiness
 
 				"route53recoveryreadiness": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// route53resolver
 
 				"route53resolver": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// rum
 
 				"rum": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"cloudwatchrum": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// s3
 
 				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"s3api": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// s3control
 
 				"s3control": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// s3outposts
 
 				"s3outposts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// s3tables
 
 				"s3tables": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// s3vectors
 
 				"s3vectors": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sagemaker
 
 				"sagemaker": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// scheduler
 
 				"scheduler": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// schemas
 
 				"schemas": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// secretsmanager
 
 				"secretsmanager": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// securityhub
 
 				"securityhub": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// securitylake
 
 				"securitylake": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// serverlessrepo
 
 				"serverlessrepo": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"serverlessapprepo": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"serverlessapplicationrepository": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// servicecatalog
 
 				"servicecatalog": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// servicecatalogappregistry
 
 				"servicecatalogappregistry": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"appregistry": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// servicediscovery
 
 				"servicediscovery": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// servicequotas
 
 				"servicequotas": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ses
 
 				"ses": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sesv2
 
 				"sesv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sfn
 
 				"sfn": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"stepfunctions": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// shield
 
 				"shield": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// signer
 
 				"signer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sns
 
 				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sqs
 
 				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssm
 
 				"ssm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssmcontacts
 
 				"ssmcontacts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssmincidents
 
 				"ssmincidents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssmquicksetup
 
 				"ssmquicksetup": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssmsap
 
 				"ssmsap": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sso
 
 				"sso": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// ssoadmin
 
 				"ssoadmin": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// storagegateway
 
 				"storagegateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// sts
 
 				"sts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// swf
 
 				"swf": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// synthetics
 
 				"synthetics": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// taxsettings
 
 				"taxsettings": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// timestreaminfluxdb
 
 				"timestreaminfluxdb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// timestreamquery
 
 				"timestreamquery": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// timestreamwrite
 
 				"timestreamwrite": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// transcribe
 
 				"transcribe": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				"transcribeservice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// transfer
 
 				"transfer": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// verifiedpermissions
 
 				"verifiedpermissions": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// vpclattice
 
 				"vpclattice": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// waf
 
 				"waf": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// wafregional
 
 				"wafregional": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// wafv2
 
 				"wafv2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// wellarchitected
 
 				"wellarchitected": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// workmail
 
 				"workmail": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// workspaces
 
 				"workspaces": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// workspacesweb
 
 				"workspacesweb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 
 				// xray
 
 				"xray": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Use this to override the default service endpoint URL",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Use this to override the default service endpoint URL",
+					ValidateFunc: validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS),
 				},
 			},
 		},
@@ -72,6 +72,158 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestEndpointsSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		value         string
+		expectedError bool
+	}{
+		"https": {
+			value: "https://sts.fake.test",
+		},
+		"http": {
+			value: "http://localhost:4566",
+		},
+		"empty": {
+			value: "",
+		},
+		"no scheme": {
+			value:         "sts.fake.test",
+			expectedError: true,
+		},
+		"invalid scheme": {
+			value:         "ftp://sts.fake.test",
+			expectedError: true,
+		},
+	}
+
+	elem := endpointsSchema().Elem.(*schema.Resource)
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, serviceKey := range names.Aliases() {
+				_, errs := elem.Schema[serviceKey].ValidateFunc(testcase.value, serviceKey)
+
+				if got, want := len(errs) > 0, testcase.expectedError; got != want {
+					t.Fatalf("%s: expected error %t, got %v", serviceKey, want, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandServiceMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap         map[string]any
+		expected      map[string]int
+		expectedError bool
+	}{
+		"package names": {
+			tfMap: map[string]any{
+				"elbv2": 50,
+				"ssm":   40,
+			},
+			expected: map[string]int{
+				"elbv2": 50,
+				"ssm":   40,
+			},
+		},
+		"alias": {
+			tfMap: map[string]any{
+				"elasticloadbalancingv2": 50,
+			},
+			expected: map[string]int{
+				"elbv2": 50,
+			},
+		},
+		"unknown service": {
+			tfMap: map[string]any{
+				"notaservice": 50,
+			},
+			expectedError: true,
+		},
+		"invalid value": {
+			tfMap: map[string]any{
+				"ssm": 0,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceMaxRetries(t.Context(), testcase.tfMap)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error %t, got %v", want, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandSkipTagsReadServices(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]struct{}
+		expectedError bool
+	}{
+		"package names": {
+			tfList: []any{"elbv2", "ssm"},
+			expected: map[string]struct{}{
+				"elbv2": {},
+				"ssm":   {},
+			},
+		},
+		"alias": {
+			tfList: []any{"elasticloadbalancingv2"},
+			expected: map[string]struct{}{
+				"elbv2": {},
+			},
+		},
+		"unknown service": {
+			tfList:        []any{"notaservice"},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandSkipTagsReadServices(t.Context(), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error %t, got %v", want, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := []struct {
@@ -82,6 +82,11 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 				return diags
 			}
 
+			// If tag reads are skipped for the service, keep tags and tags_all from state.
+			if tagsInContext.TagsOut.IsNone() && c.SkipTagsRead(ctx, sp.ServicePackageName()) {
+				return diags
+			}
+
 			fallthrough
 		case Create, Update:
 			// If the R handler didn't set tags, try and read them from the service API.
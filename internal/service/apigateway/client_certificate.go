@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -23,6 +25,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// clientCertificateDateLayout is the layout of time.Time.String(), used for the created_date and expiration_date attributes.
+	clientCertificateDateLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
 // @SDKResource("aws_api_gateway_client_certificate", name="Client Certificate")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/apigateway;apigateway.GetClientCertificateOutput", generator=false)
@@ -37,6 +44,8 @@ func resourceClientCertificate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffClientCertificateRotation,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -58,6 +67,15 @@ func resourceClientCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ready_for_rotation": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rotation_window_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 364),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -114,6 +132,7 @@ func resourceClientCertificateRead(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrDescription, cert.Description)
 	d.Set("expiration_date", cert.ExpirationDate.String())
 	d.Set("pem_encoded_certificate", cert.PemEncodedCertificate)
+	d.Set("ready_for_rotation", clientCertificateReadyForRotation(aws.ToTime(cert.ExpirationDate), d.Get("rotation_window_days").(int), time.Now()))
 
 	setTagsOut(ctx, cert.Tags)
 
@@ -124,7 +143,7 @@ func resourceClientCertificateUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange(names.AttrDescription) {
 		input := apigateway.UpdateClientCertificateInput{
 			ClientCertificateId: aws.String(d.Id()),
 			PatchOperations: []types.PatchOperation{
@@ -167,6 +186,53 @@ func resourceClientCertificateDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+// customizeDiffClientCertificateRotation replaces the certificate once it is within rotation_window_days of expiring.
+// Combined with the create_before_destroy lifecycle meta-argument, stages referencing the certificate are updated
+// to the new certificate before the old one is deleted.
+func customizeDiffClientCertificateRotation(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	var ready bool
+	if window := d.Get("rotation_window_days").(int); window != 0 {
+		expirationDate, err := time.Parse(clientCertificateDateLayout, d.Get("expiration_date").(string))
+
+		if err != nil {
+			return fmt.Errorf("parsing expiration_date: %w", err)
+		}
+
+		ready = clientCertificateReadyForRotation(expirationDate, window, time.Now())
+	}
+
+	if !ready {
+		if d.HasChange("rotation_window_days") {
+			return d.SetNew("ready_for_rotation", false)
+		}
+
+		return nil
+	}
+
+	// The replacement certificate has a new expiration date.
+	if err := d.SetNewComputed("ready_for_rotation"); err != nil {
+		return err
+	}
+
+	if err := d.SetNewComputed("expiration_date"); err != nil {
+		return err
+	}
+
+	return d.ForceNew("expiration_date")
+}
+
+func clientCertificateReadyForRotation(expirationDate time.Time, windowDays int, now time.Time) bool {
+	if windowDays == 0 {
+		return false
+	}
+
+	return !now.Before(expirationDate.AddDate(0, 0, -windowDays))
+}
+
 func findClientCertificateByID(ctx context.Context, conn *apigateway.Client, id string) (*apigateway.GetClientCertificateOutput, error) {
 	input := apigateway.GetClientCertificateInput{
 		ClientCertificateId: aws.String(id),
@@ -7,10 +7,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -78,6 +81,101 @@ func TestAccAPIGatewayClientCertificate_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayClientCertificate_rotationWindowDays(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 apigateway.GetClientCertificateOutput
+	resourceName := "aws_api_gateway_client_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientCertificateConfig_rotationWindowDays(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientCertificateExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_rotation", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rotation_window_days", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_window_days"},
+			},
+			// Certificates are valid for one year, so a newly created certificate is not yet within a 364 day window.
+			{
+				Config: testAccClientCertificateConfig_rotationWindowDays(364),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientCertificateExists(ctx, resourceName, &conf2),
+					testAccCheckClientCertificateNotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_rotation", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rotation_window_days", "364"),
+				),
+			},
+		},
+	})
+}
+
+func TestClientCertificateReadyForRotation(t *testing.T) {
+	t.Parallel()
+
+	expirationDate := time.Date(2025, time.June, 30, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		windowDays int
+		now        time.Time
+		expected   bool
+	}{
+		"no window": {
+			windowDays: 0,
+			now:        expirationDate,
+			expected:   false,
+		},
+		"before window": {
+			windowDays: 30,
+			now:        expirationDate.AddDate(0, 0, -31),
+			expected:   false,
+		},
+		"start of window": {
+			windowDays: 30,
+			now:        expirationDate.AddDate(0, 0, -30),
+			expected:   true,
+		},
+		"in window": {
+			windowDays: 30,
+			now:        expirationDate.AddDate(0, 0, -1),
+			expected:   true,
+		},
+		"expired": {
+			windowDays: 30,
+			now:        expirationDate.AddDate(0, 0, 1),
+			expected:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfapigateway.ClientCertificateReadyForRotation(expirationDate, testCase.windowDays, testCase.now), testCase.expected; got != want {
+				t.Errorf("ClientCertificateReadyForRotation() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func testAccCheckClientCertificateExists(ctx context.Context, n string, v *apigateway.GetClientCertificateOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -99,6 +197,16 @@ func testAccCheckClientCertificateExists(ctx context.Context, n string, v *apiga
 	}
 }
 
+func testAccCheckClientCertificateNotRecreated(before, after *apigateway.GetClientCertificateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.ClientCertificateId), aws.ToString(after.ClientCertificateId); before != after {
+			return fmt.Errorf("API Gateway Client Certificate (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckClientCertificateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
@@ -136,3 +244,16 @@ resource "aws_api_gateway_client_certificate" "test" {
   description = "Hello from TF acceptance test - updated"
 }
 `
+
+func testAccClientCertificateConfig_rotationWindowDays(days int) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_client_certificate" "test" {
+  description          = "Hello from TF acceptance test"
+  rotation_window_days = %[1]d
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, days)
+}
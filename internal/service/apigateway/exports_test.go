@@ -31,6 +31,7 @@ var (
 	ResourceUsagePlanKey                = resourceUsagePlanKey
 	ResourceVPCLink                     = resourceVPCLink
 
+	ClientCertificateReadyForRotation    = clientCertificateReadyForRotation
 	DefaultAuthorizerTTL                 = defaultAuthorizerTTL
 	FindAPIKeyByID                       = findAPIKeyByID
 	FindAccount                          = findAccount
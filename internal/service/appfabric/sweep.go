@@ -16,8 +16,10 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_appfabric_app_bundle", sweepAppBundles, "aws_appfabric_app_authorization")
+	awsv2.Register("aws_appfabric_app_bundle", sweepAppBundles, "aws_appfabric_app_authorization", "aws_appfabric_ingestion")
 	awsv2.Register("aws_appfabric_app_authorization", sweepAppAuthorizations)
+	awsv2.Register("aws_appfabric_ingestion", sweepIngestions, "aws_appfabric_ingestion_destination")
+	awsv2.Register("aws_appfabric_ingestion_destination", sweepIngestionDestinations)
 }
 
 func sweepAppBundles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -80,3 +82,99 @@ func sweepAppAuthorizations(ctx context.Context, client *conns.AWSClient) ([]swe
 
 	return sweepResources, nil
 }
+
+func sweepIngestions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.AppFabricClient(ctx)
+	input := &appfabric.ListAppBundlesInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := appfabric.NewListAppBundlesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AppBundleSummaryList {
+			appBundleARN := aws.ToString(v.Arn)
+			input := &appfabric.ListIngestionsInput{
+				AppBundleIdentifier: aws.String(appBundleARN),
+			}
+
+			pages := appfabric.NewListIngestionsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Ingestions {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newIngestionResource, client,
+						framework.NewAttribute("app_bundle_arn", appBundleARN),
+						framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepIngestionDestinations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.AppFabricClient(ctx)
+	input := &appfabric.ListAppBundlesInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := appfabric.NewListAppBundlesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AppBundleSummaryList {
+			appBundleARN := aws.ToString(v.Arn)
+			input := &appfabric.ListIngestionsInput{
+				AppBundleIdentifier: aws.String(appBundleARN),
+			}
+
+			pages := appfabric.NewListIngestionsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Ingestions {
+					ingestionARN := aws.ToString(v.Arn)
+					input := &appfabric.ListIngestionDestinationsInput{
+						AppBundleIdentifier: aws.String(appBundleARN),
+						IngestionIdentifier: aws.String(ingestionARN),
+					}
+
+					pages := appfabric.NewListIngestionDestinationsPaginator(conn, input)
+					for pages.HasMorePages() {
+						page, err := pages.NextPage(ctx)
+
+						if err != nil {
+							return nil, err
+						}
+
+						for _, v := range page.IngestionDestinations {
+							sweepResources = append(sweepResources, framework.NewSweepResource(newIngestionDestinationResource, client,
+								framework.NewAttribute("app_bundle_arn", appBundleARN),
+								framework.NewAttribute("ingestion_arn", ingestionARN),
+								framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn))))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
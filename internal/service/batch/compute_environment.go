@@ -13,6 +13,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/batch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,7 +44,13 @@ func resourceComputeEnvironment() *schema.Resource {
 		DeleteWithoutTimeout: resourceComputeEnvironmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceComputeEnvironmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
 		CustomizeDiff: resourceComputeEnvironmentCustomizeDiff,
@@ -105,7 +112,6 @@ func resourceComputeEnvironment() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 2,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -139,7 +145,7 @@ func resourceComputeEnvironment() *schema.Resource {
 						"instance_role": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNOfService("iam", "instance-profile/"),
 						},
 						names.AttrInstanceType: {
 							Type:     schema.TypeSet,
@@ -149,7 +155,6 @@ func resourceComputeEnvironment() *schema.Resource {
 						names.AttrLaunchTemplate: {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -182,7 +187,6 @@ func resourceComputeEnvironment() *schema.Resource {
 						"placement_group": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						names.AttrSecurityGroupIDs: {
 							Type:     schema.TypeSet,
@@ -193,7 +197,7 @@ func resourceComputeEnvironment() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNOfService("iam", "role/"),
 						},
 						names.AttrSubnets: {
 							Type:     schema.TypeSet,
@@ -236,11 +240,16 @@ func resourceComputeEnvironment() *schema.Resource {
 					},
 				},
 			},
+			"ignore_desired_vcpus_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrServiceRole: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfService("iam", "role/"),
 			},
 			names.AttrState: {
 				Type:             schema.TypeString,
@@ -329,6 +338,13 @@ func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Batch Compute Environment (%s) create: %s", d.Id(), err)
 	}
 
+	// The ECS cluster backing an UNMANAGED compute environment is associated asynchronously.
+	if _, ok := d.GetOk("eks_configuration"); !ok && computeEnvironmentType == awstypes.CETypeUnmanaged {
+		if err := waitComputeEnvironmentECSClusterARNAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Batch Compute Environment (%s) ECS cluster: %s", d.Id(), err)
+		}
+	}
+
 	// UpdatePolicy is not possible to set with CreateComputeEnvironment
 	if v, ok := d.GetOk("update_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input := &batch.UpdateComputeEnvironmentInput{
@@ -398,11 +414,30 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceComputeEnvironmentImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	// Compute environments can be imported by name or by ARN.
+	if arn.IsARN(d.Id()) {
+		v, err := arn.Parse(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		name, found := strings.CutPrefix(v.Resource, "compute-environment/")
+		if !found || name == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%s), expected NAME or arn:PARTITION:batch:REGION:ACCOUNT:compute-environment/NAME", d.Id())
+		}
+
+		d.SetId(name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BatchClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("ignore_desired_vcpus_changes", names.AttrTags, names.AttrTagsAll) {
 		input := &batch.UpdateComputeEnvironmentInput{
 			ComputeEnvironment: aws.String(d.Id()),
 		}
@@ -514,6 +549,14 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecificationUpdate(launchTemplate)
 				}
 
+				if d.HasChange("compute_resources.0.placement_group") {
+					if placementGroup, ok := d.GetOk("compute_resources.0.placement_group"); ok {
+						computeResourceUpdate.PlacementGroup = aws.String(placementGroup.(string))
+					} else {
+						computeResourceUpdate.PlacementGroup = aws.String("")
+					}
+				}
+
 				if d.HasChange("compute_resources.0.tags") {
 					if tags, ok := d.GetOk("compute_resources.0.tags"); ok {
 						computeResourceUpdate.Tags = svcTags(tftags.New(ctx, tags.(map[string]any)).IgnoreAWS())
@@ -593,6 +636,14 @@ func resourceComputeEnvironmentCustomizeDiff(ctx context.Context, diff *schema.R
 
 		fargateComputeResources := isFargateType(awstypes.CRType(diff.Get("compute_resources.0.type").(string)))
 
+		// AWS Batch scales DesiredvCpus in response to job demand.
+		// Optionally leave the service-managed value alone rather than resetting it on every apply.
+		if diff.Get("ignore_desired_vcpus_changes").(bool) && diff.HasChange("compute_resources.0.desired_vcpus") {
+			if err := diff.Clear("compute_resources.0.desired_vcpus"); err != nil {
+				return err
+			}
+		}
+
 		if !isUpdatableComputeEnvironment(diff) {
 			if diff.HasChange("compute_resources.0.security_group_ids") && !fargateComputeResources {
 				if err := diff.ForceNew("compute_resources.0.security_group_ids"); err != nil {
@@ -703,6 +754,12 @@ func resourceComputeEnvironmentCustomizeDiff(ctx context.Context, diff *schema.R
 				}
 			}
 
+			if diff.HasChange("compute_resources.0.placement_group") {
+				if err := diff.ForceNew("compute_resources.0.placement_group"); err != nil {
+					return err
+				}
+			}
+
 			if diff.HasChange("compute_resources.0.tags") {
 				if err := diff.ForceNew("compute_resources.0.tags"); err != nil {
 					return err
@@ -797,6 +854,26 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Client, name
 	return nil, err
 }
 
+func waitComputeEnvironmentECSClusterARNAvailable(ctx context.Context, conn *batch.Client, name string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func(ctx context.Context) (bool, error) {
+		output, err := findComputeEnvironmentDetailByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if output.Status == awstypes.CEStatusInvalid {
+			return false, fmt.Errorf("compute environment is %s: %s", output.Status, aws.ToString(output.StatusReason))
+		}
+
+		return aws.ToString(output.EcsClusterArn) != "", nil
+	}, tfresource.WaitOpts{})
+}
+
 func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Client, name string, timeout time.Duration) (*awstypes.ComputeEnvironmentDetail, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.CEStatusUpdating),
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_batch_consumable_resource", name="Consumable Resource")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/batch;batch.DescribeConsumableResourceOutput")
+// @Testing(tagsTest=false)
+func resourceConsumableResource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConsumableResourceCreate,
+		ReadWithoutTimeout:   resourceConsumableResourceRead,
+		UpdateWithoutTimeout: resourceConsumableResourceUpdate,
+		DeleteWithoutTimeout: resourceConsumableResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"available_quantity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"in_use_quantity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only letters, numbers, hyphens, and underscores"),
+				),
+			},
+			names.AttrResourceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      consumableResourceTypeReplenishable,
+				ValidateFunc: validation.StringInSlice(consumableResourceType_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"total_quantity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceConsumableResourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &batch.CreateConsumableResourceInput{
+		ConsumableResourceName: aws.String(name),
+		ResourceType:           aws.String(d.Get(names.AttrResourceType).(string)),
+		Tags:                   getTagsIn(ctx),
+		TotalQuantity:          aws.Int64(int64(d.Get("total_quantity").(int))),
+	}
+
+	output, err := conn.CreateConsumableResource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Batch Consumable Resource (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ConsumableResourceArn))
+
+	return append(diags, resourceConsumableResourceRead(ctx, d, meta)...)
+}
+
+func resourceConsumableResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchClient(ctx)
+
+	output, err := findConsumableResourceByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Batch Consumable Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Batch Consumable Resource (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ConsumableResourceArn)
+	d.Set("available_quantity", output.AvailableQuantity)
+	if v := output.CreatedAt; v != nil {
+		d.Set(names.AttrCreatedAt, time.UnixMilli(aws.ToInt64(v)).UTC().Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreatedAt, nil)
+	}
+	d.Set("in_use_quantity", output.InUseQuantity)
+	d.Set(names.AttrName, output.ConsumableResourceName)
+	d.Set(names.AttrResourceType, output.ResourceType)
+	d.Set("total_quantity", output.TotalQuantity)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceConsumableResourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchClient(ctx)
+
+	if d.HasChange("total_quantity") {
+		input := &batch.UpdateConsumableResourceInput{
+			ConsumableResource: aws.String(d.Id()),
+			Operation:          aws.String(consumableResourceUpdateOperationSet),
+			Quantity:           aws.Int64(int64(d.Get("total_quantity").(int))),
+		}
+
+		_, err := conn.UpdateConsumableResource(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Batch Consumable Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConsumableResourceRead(ctx, d, meta)...)
+}
+
+func resourceConsumableResourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Batch Consumable Resource: %s", d.Id())
+	input := batch.DeleteConsumableResourceInput{
+		ConsumableResource: aws.String(d.Id()),
+	}
+	_, err := conn.DeleteConsumableResource(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "does not exist") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Batch Consumable Resource (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConsumableResourceByARN(ctx context.Context, conn *batch.Client, arn string) (*batch.DescribeConsumableResourceOutput, error) {
+	input := &batch.DescribeConsumableResourceInput{
+		ConsumableResource: aws.String(arn),
+	}
+
+	output, err := conn.DescribeConsumableResource(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBatchConsumableResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "batch", "consumable-resource/{name}"),
+					resource.TestCheckResourceAttr(resourceName, "available_quantity", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "in_use_quantity", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "REPLENISHABLE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "total_quantity", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "available_quantity", "20"),
+					resource.TestCheckResourceAttr(resourceName, "total_quantity", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchConsumableResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbatch.ResourceConsumableResource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchConsumableResource_nonReplenishable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_resourceType(rName, "NON_REPLENISHABLE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "NON_REPLENISHABLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchConsumableResource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v batch.DescribeConsumableResourceOutput
+	resourceName := "aws_batch_consumable_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConsumableResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConsumableResourceConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConsumableResourceConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConsumableResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConsumableResourceExists(ctx context.Context, n string, v *batch.DescribeConsumableResourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
+
+		output, err := tfbatch.FindConsumableResourceByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConsumableResourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_batch_consumable_resource" {
+				continue
+			}
+
+			_, err := tfbatch.FindConsumableResourceByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Batch Consumable Resource %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccConsumableResourceConfig_basic(rName string, totalQuantity int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  total_quantity = %[2]d
+}
+`, rName, totalQuantity)
+}
+
+func testAccConsumableResourceConfig_resourceType(rName, resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  resource_type  = %[2]q
+  total_quantity = 10
+}
+`, rName, resourceType)
+}
+
+func testAccConsumableResourceConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  total_quantity = 10
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
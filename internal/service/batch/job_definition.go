@@ -70,6 +70,33 @@ func resourceJobDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"consumable_resource_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumable_resource_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumable_resource": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"quantity": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"container_properties": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -378,11 +405,20 @@ func resourceJobDefinition() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"annotations": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
 												"labels": {
 													Type:     schema.TypeMap,
 													Optional: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
+												names.AttrNamespace: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
 											},
 										},
 									},
@@ -718,6 +754,7 @@ func needsJobDefUpdate(d *schema.ResourceDiff) bool {
 	}
 
 	if d.HasChanges(
+		"consumable_resource_properties",
 		names.AttrPropagateTags,
 		names.AttrParameters,
 		"platform_capabilities",
@@ -743,6 +780,10 @@ func resourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, me
 		Type:              jobDefinitionType,
 	}
 
+	if v, ok := d.GetOk("consumable_resource_properties"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.ConsumableResourceProperties = expandConsumableResourceProperties(v.([]any)[0].(map[string]any))
+	}
+
 	switch jobDefinitionType {
 	case awstypes.JobDefinitionTypeContainer:
 		if v, ok := d.GetOk("node_properties"); ok && v != nil {
@@ -859,6 +900,13 @@ func resourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, meta
 	arn, revision := aws.ToString(jobDefinition.JobDefinitionArn), aws.ToInt32(jobDefinition.Revision)
 	d.Set(names.AttrARN, arn)
 	d.Set("arn_prefix", strings.TrimSuffix(arn, fmt.Sprintf(":%d", revision)))
+	if jobDefinition.ConsumableResourceProperties != nil {
+		if err := d.Set("consumable_resource_properties", []any{flattenConsumableResourceProperties(jobDefinition.ConsumableResourceProperties)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting consumable_resource_properties: %s", err)
+		}
+	} else {
+		d.Set("consumable_resource_properties", nil)
+	}
 	containerProperties, err := flattenContainerProperties(jobDefinition.ContainerProperties)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -919,6 +967,10 @@ func resourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, me
 			Type:              jobDefinitionType,
 		}
 
+		if v, ok := d.GetOk("consumable_resource_properties"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.ConsumableResourceProperties = expandConsumableResourceProperties(v.([]any)[0].(map[string]any))
+		}
+
 		switch jobDefinitionType {
 		case awstypes.JobDefinitionTypeContainer:
 			if v, ok := d.GetOk("container_properties"); ok {
@@ -1050,6 +1102,12 @@ func resourceJobDefinitionDelete(ctx context.Context, d *schema.ResourceData, me
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "deregistering Batch Job Definition (%s): %s", arn, err)
 		}
+
+		if _, err := tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+			return findJobDefinitionByARN(ctx, conn, arn)
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Batch Job Definition (%s) deregister: %s", arn, err)
+		}
 	}
 
 	return diags
@@ -1258,6 +1316,63 @@ func flattenEvaluateOnExits(apiObjects []awstypes.EvaluateOnExit) []any {
 	return tfList
 }
 
+func expandConsumableResourceProperties(tfMap map[string]any) *awstypes.ConsumableResourceProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ConsumableResourceProperties{}
+
+	if v, ok := tfMap["consumable_resource_list"].([]any); ok && len(v) > 0 {
+		apiObject.ConsumableResourceList = expandConsumableResourceRequirements(v)
+	}
+
+	return apiObject
+}
+
+func expandConsumableResourceRequirements(tfList []any) []awstypes.ConsumableResourceRequirement {
+	var apiObjects []awstypes.ConsumableResourceRequirement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.ConsumableResourceRequirement{
+			ConsumableResource: aws.String(tfMap["consumable_resource"].(string)),
+			Quantity:           aws.Int64(int64(tfMap["quantity"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenConsumableResourceProperties(apiObject *awstypes.ConsumableResourceProperties) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"consumable_resource_list": flattenConsumableResourceRequirements(apiObject.ConsumableResourceList),
+	}
+
+	return tfMap
+}
+
+func flattenConsumableResourceRequirements(apiObjects []awstypes.ConsumableResourceRequirement) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"consumable_resource": aws.ToString(apiObject.ConsumableResource),
+			"quantity":            aws.ToInt64(apiObject.Quantity),
+		})
+	}
+
+	return tfList
+}
+
 func expandJobTimeout(tfMap map[string]any) *awstypes.JobTimeout {
 	if tfMap == nil {
 		return nil
@@ -1325,12 +1440,23 @@ func expandEKSPodProperties(tfMap map[string]any) *awstypes.EksPodProperties {
 		apiObject.InitContainers = expandContainers(v.([]any))
 	}
 
-	if v, ok := tfMap["metadata"].([]any); ok && len(v) > 0 {
-		if v, ok := v[0].(map[string]any)["labels"]; ok {
-			apiObject.Metadata = &awstypes.EksMetadata{
-				Labels: flex.ExpandStringValueMap(v.(map[string]any)),
-			}
+	if v, ok := tfMap["metadata"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		metadata := &awstypes.EksMetadata{}
+
+		if v, ok := tfMap["annotations"].(map[string]any); ok && len(v) > 0 {
+			metadata.Annotations = flex.ExpandStringValueMap(v)
 		}
+
+		if v, ok := tfMap["labels"].(map[string]any); ok && len(v) > 0 {
+			metadata.Labels = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap[names.AttrNamespace].(string); ok && v != "" {
+			metadata.Namespace = aws.String(v)
+		}
+
+		apiObject.Metadata = metadata
 	}
 
 	if v, ok := tfMap["service_account_name"].(string); ok && v != "" {
@@ -1582,9 +1708,11 @@ func flattenEKSPodProperties(apiObject *awstypes.EksPodProperties) []any {
 	if v := apiObject.Metadata; v != nil {
 		metadata := make([]map[string]any, 0)
 
-		if v := v.Labels; v != nil {
+		if v.Annotations != nil || v.Labels != nil || v.Namespace != nil {
 			metadata = append(metadata, map[string]any{
-				"labels": v,
+				"annotations":       v.Annotations,
+				"labels":            v.Labels,
+				names.AttrNamespace: aws.ToString(v.Namespace),
 			})
 		}
 
@@ -53,6 +53,7 @@ func (d *jobDefinitionDataSource) Schema(ctx context.Context, request datasource
 			"container_orchestration_type": schema.StringAttribute{
 				Computed: true,
 			},
+			"container_properties": framework.DataSourceComputedListOfObjectAttribute[containerPropertiesModel](ctx),
 			"eks_properties": schema.ListAttribute{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[eksPropertiesModel](ctx),
 				Computed:   true,
@@ -212,20 +213,21 @@ func (d *jobDefinitionDataSource) ConfigValidators(context.Context) []resource.C
 
 type jobDefinitionDataSourceModel struct {
 	framework.WithRegionModel
-	ARNPrefix                  types.String                                         `tfsdk:"arn_prefix"`
-	ContainerOrchestrationType types.String                                         `tfsdk:"container_orchestration_type"`
-	EKSProperties              fwtypes.ListNestedObjectValueOf[eksPropertiesModel]  `tfsdk:"eks_properties"`
-	ID                         types.String                                         `tfsdk:"id"`
-	JobDefinitionARN           fwtypes.ARN                                          `tfsdk:"arn"`
-	JobDefinitionName          types.String                                         `tfsdk:"name"`
-	NodeProperties             fwtypes.ListNestedObjectValueOf[nodePropertiesModel] `tfsdk:"node_properties"`
-	RetryStrategy              fwtypes.ListNestedObjectValueOf[retryStrategyModel]  `tfsdk:"retry_strategy"`
-	Revision                   types.Int64                                          `tfsdk:"revision"`
-	SchedulingPriority         types.Int64                                          `tfsdk:"scheduling_priority"`
-	Status                     types.String                                         `tfsdk:"status"`
-	Tags                       tftags.Map                                           `tfsdk:"tags"`
-	Timeout                    fwtypes.ListNestedObjectValueOf[jobTimeoutModel]     `tfsdk:"timeout"`
-	Type                       types.String                                         `tfsdk:"type"`
+	ARNPrefix                  types.String                                              `tfsdk:"arn_prefix"`
+	ContainerOrchestrationType types.String                                              `tfsdk:"container_orchestration_type"`
+	ContainerProperties        fwtypes.ListNestedObjectValueOf[containerPropertiesModel] `tfsdk:"container_properties"`
+	EKSProperties              fwtypes.ListNestedObjectValueOf[eksPropertiesModel]       `tfsdk:"eks_properties"`
+	ID                         types.String                                              `tfsdk:"id"`
+	JobDefinitionARN           fwtypes.ARN                                               `tfsdk:"arn"`
+	JobDefinitionName          types.String                                              `tfsdk:"name"`
+	NodeProperties             fwtypes.ListNestedObjectValueOf[nodePropertiesModel]      `tfsdk:"node_properties"`
+	RetryStrategy              fwtypes.ListNestedObjectValueOf[retryStrategyModel]       `tfsdk:"retry_strategy"`
+	Revision                   types.Int64                                               `tfsdk:"revision"`
+	SchedulingPriority         types.Int64                                               `tfsdk:"scheduling_priority"`
+	Status                     types.String                                              `tfsdk:"status"`
+	Tags                       tftags.Map                                                `tfsdk:"tags"`
+	Timeout                    fwtypes.ListNestedObjectValueOf[jobTimeoutModel]          `tfsdk:"timeout"`
+	Type                       types.String                                              `tfsdk:"type"`
 }
 
 type eksPropertiesModel struct {
@@ -285,7 +287,9 @@ type eksContainerVolumeMountModel struct {
 }
 
 type eksMetadataModel struct {
-	Labels fwtypes.MapValueOf[types.String] `tfsdk:"labels"`
+	Annotations fwtypes.MapValueOf[types.String] `tfsdk:"annotations"`
+	Labels      fwtypes.MapValueOf[types.String] `tfsdk:"labels"`
+	Namespace   types.String                     `tfsdk:"namespace"`
 }
 
 type eksVolumeModel struct {
@@ -33,10 +33,22 @@ func TestAccBatchJobDefinitionDataSource_basicName(t *testing.T) {
 				Config: testAccJobDefinitionDataSourceConfig_basicName(rName, "1"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "container_properties.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_properties.0.command.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_properties.0.command.1", "test1"),
+					resource.TestCheckResourceAttr(dataSourceName, "container_properties.0.image", "busybox"),
 					resource.TestCheckResourceAttr(dataSourceName, "retry_strategy.0.attempts", "10"),
 					resource.TestCheckResourceAttr(dataSourceName, "revision", "1"),
 				),
 			},
+			{
+				Config: testAccJobDefinitionDataSourceConfig_basicName(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "container_properties.0.command.1", "test2"),
+					resource.TestCheckResourceAttr(dataSourceName, "revision", "2"),
+				),
+			},
 			{
 				Config: testAccJobDefinitionDataSourceConfig_basicNameRevision(rName, "2", 2),
 				Check: resource.ComposeTestCheckFunc(
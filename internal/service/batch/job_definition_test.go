@@ -395,6 +395,39 @@ func TestAccBatchJobDefinition_PlatformCapabilities_fargate(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_PlatformCapabilitiesFargate_ephemeralStorageRuntimePlatform(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd awstypes.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_capabilitiesFargateEphemeralStorageRuntimePlatform(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "ephemeralStorage.sizeInGiB", "50"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "fargatePlatformConfiguration.platformVersion", "1.4.0"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "networkConfiguration.assignPublicIp", "ENABLED"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "runtimePlatform.cpuArchitecture", "ARM64"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_properties", "runtimePlatform.operatingSystemFamily", "LINUX"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccBatchJobDefinition_ContainerProperties_advanced(t *testing.T) {
 	ctx := acctest.Context(t)
 	var jd awstypes.JobDefinition
@@ -962,6 +995,10 @@ func TestAccBatchJobDefinition_EKSProperties_update(t *testing.T) {
 					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
 					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image_pull_policy", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.annotations.example.com/owner", "batch"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.namespace", "default"),
 					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.0.name", "tmp"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, "container"),
@@ -1106,6 +1143,46 @@ func TestAccBatchJobDefinition_schedulingPriority(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_consumableResourceProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jd awstypes.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDefinitionConfig_consumableResourceProperties(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "consumable_resource_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "consumable_resource_properties.0.consumable_resource_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "consumable_resource_properties.0.consumable_resource_list.0.consumable_resource", "aws_batch_consumable_resource.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "consumable_resource_properties.0.consumable_resource_list.0.quantity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobDefinitionConfig_consumableResourceProperties(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobDefinitionExists(ctx, resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "consumable_resource_properties.0.consumable_resource_list.0.quantity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBatchJobDefinition_emptyRetryStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var jd awstypes.JobDefinition
@@ -1776,6 +1853,67 @@ CONTAINER_PROPERTIES
 `, rName)
 }
 
+func testAccJobDefinitionConfig_capabilitiesFargateEphemeralStorageRuntimePlatform(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "ecs_task_execution_role" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role_policy.json
+}
+
+data "aws_iam_policy_document" "assume_role_policy" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
+  role       = aws_iam_role.ecs_task_execution_role.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = [
+    "FARGATE",
+  ]
+
+  container_properties = <<CONTAINER_PROPERTIES
+{
+  "command": ["echo", "test"],
+  "image": "busybox",
+  "ephemeralStorage": {
+    "sizeInGiB": 50
+  },
+  "fargatePlatformConfiguration": {
+    "platformVersion": "1.4.0"
+  },
+  "networkConfiguration": {
+    "assignPublicIp": "ENABLED"
+  },
+  "runtimePlatform": {
+    "cpuArchitecture": "ARM64",
+    "operatingSystemFamily": "LINUX"
+  },
+  "resourceRequirements": [
+    {"type": "VCPU", "value": "0.25"},
+    {"type": "MEMORY", "value": "512"}
+  ],
+  "executionRoleArn": "${aws_iam_role.ecs_task_execution_role.arn}"
+}
+CONTAINER_PROPERTIES
+}
+`, rName)
+}
+
 func testAccJobDefinitionConfig_propagateTags(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_batch_job_definition" "test" {
@@ -2154,10 +2292,14 @@ resource "aws_batch_job_definition" "test" {
         }
       }
       metadata {
+        annotations = {
+          "example.com/owner" = "batch"
+        }
         labels = {
           environment = "test"
           name        = %[1]q
         }
+        namespace = "default"
       }
       volumes {
         name = "tmp"
@@ -2266,6 +2408,33 @@ resource "aws_batch_job_definition" "test" {
 `, rName, priority)
 }
 
+func testAccJobDefinitionConfig_consumableResourceProperties(rName string, quantity int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_consumable_resource" "test" {
+  name           = %[1]q
+  total_quantity = 10
+}
+
+resource "aws_batch_job_definition" "test" {
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+  name = %[1]q
+  type = "container"
+
+  consumable_resource_properties {
+    consumable_resource_list {
+      consumable_resource = aws_batch_consumable_resource.test.arn
+      quantity            = %[2]d
+    }
+  }
+}
+`, rName, quantity)
+}
+
 func testAccJobDefinitionConfig_attributes(rName string, sp int, pt bool, rsa int, timeout int, dereg bool) string {
 	return fmt.Sprintf(`
 resource "aws_batch_job_definition" "test" {
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -79,7 +80,12 @@ func (r *jobQueueResource) Schema(ctx context.Context, request resource.SchemaRe
 		Version: 2,
 		Attributes: map[string]schema.Attribute{
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
-			names.AttrID:  framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrARN)),
+			names.AttrForceDelete: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrARN)),
 			names.AttrName: schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -235,6 +241,11 @@ func (r *jobQueueResource) Read(ctx context.Context, request resource.ReadReques
 		return
 	}
 
+	// force_delete is not returned by the API, e.g. on import.
+	if data.ForceDelete.IsNull() {
+		data.ForceDelete = types.BoolValue(false)
+	}
+
 	setTagsOut(ctx, jobQueue.Tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -350,6 +361,20 @@ func (r *jobQueueResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	if data.ForceDelete.ValueBool() {
+		if err := terminateJobQueueJobs(ctx, conn, data.ID.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("terminating Batch Job Queue (%s) jobs", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if err := waitJobQueueJobsTerminated(ctx, conn, data.ID.ValueString(), timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Batch Job Queue (%s) jobs terminate", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	deleteInput := batch.DeleteJobQueueInput{
 		JobQueue: fwflex.StringFromFramework(ctx, data.ID),
 	}
@@ -408,6 +433,74 @@ func findJobQueue(ctx context.Context, conn *batch.Client, input *batch.Describe
 	return tfresource.AssertSingleValueResultIterErr(listJobQueues(ctx, conn, input))
 }
 
+// jobQueueActiveJobStatuses are the statuses of jobs that have not yet finished.
+var jobQueueActiveJobStatuses = []awstypes.JobStatus{
+	awstypes.JobStatusSubmitted,
+	awstypes.JobStatusPending,
+	awstypes.JobStatusRunnable,
+	awstypes.JobStatusStarting,
+	awstypes.JobStatusRunning,
+}
+
+func findJobQueueActiveJobs(ctx context.Context, conn *batch.Client, jobQueue string) ([]awstypes.JobSummary, error) {
+	var output []awstypes.JobSummary
+
+	for _, status := range jobQueueActiveJobStatuses {
+		input := batch.ListJobsInput{
+			JobQueue:  aws.String(jobQueue),
+			JobStatus: status,
+		}
+
+		pages := batch.NewListJobsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, page.JobSummaryList...)
+		}
+	}
+
+	return output, nil
+}
+
+func terminateJobQueueJobs(ctx context.Context, conn *batch.Client, jobQueue string) error {
+	jobs, err := findJobQueueActiveJobs(ctx, conn, jobQueue)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, job := range jobs {
+		input := batch.TerminateJobInput{
+			JobId:  job.JobId,
+			Reason: aws.String("Terminated by Terraform before deleting job queue"),
+		}
+
+		if _, err := conn.TerminateJob(ctx, &input); err != nil {
+			errs = append(errs, fmt.Errorf("terminating Batch Job (%s): %w", aws.ToString(job.JobId), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func waitJobQueueJobsTerminated(ctx context.Context, conn *batch.Client, jobQueue string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func(ctx context.Context) (bool, error) {
+		jobs, err := findJobQueueActiveJobs(ctx, conn, jobQueue)
+
+		if err != nil {
+			return false, err
+		}
+
+		return len(jobs) == 0, nil
+	}, tfresource.WaitOpts{})
+}
+
 func statusJobQueue(ctx context.Context, conn *batch.Client, id string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findJobQueueByID(ctx, conn, id)
@@ -490,6 +583,7 @@ func waitJobQueueDeleted(ctx context.Context, conn *batch.Client, id string, tim
 type jobQueueResourceModel struct {
 	framework.WithRegionModel
 	ComputeEnvironmentOrder  fwtypes.ListNestedObjectValueOf[computeEnvironmentOrderModel] `tfsdk:"compute_environment_order"`
+	ForceDelete              types.Bool                                                    `tfsdk:"force_delete"`
 	ID                       types.String                                                  `tfsdk:"id"`
 	JobQueueARN              types.String                                                  `tfsdk:"arn"`
 	JobQueueName             types.String                                                  `tfsdk:"name"`
@@ -608,6 +702,7 @@ func (r jobQueueResource) List(ctx context.Context, request list.ListRequest, st
 			if diags := fwflex.Flatten(ctx, jobQueue, &data, fwflex.WithFieldNamePrefix("JobQueue")); diags.HasError() {
 				result.Diagnostics.Append(diags...)
 			}
+			data.ForceDelete = types.BoolValue(false)
 
 			setTagsOut(ctx, jobQueue.Tags)
 
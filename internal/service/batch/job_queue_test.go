@@ -41,6 +41,7 @@ func TestAccBatchJobQueue_basic(t *testing.T) {
 					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "batch", "job-queue/{name}"),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_order.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "compute_environment_order.0.compute_environment", "aws_batch_compute_environment.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDelete, acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "job_state_time_limit_action.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "1"),
@@ -151,6 +152,11 @@ func TestAccBatchJobQueue_ComputeEnvironmentOrder_multiple(t *testing.T) {
 			},
 			{
 				Config: testAccJobQueueConfig_ComputeEnvironmentOrder_multiple(rName, string(awstypes.JQStateEnabled), 2, 1, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckJobQueueExists(ctx, resourceName, &jobQueue1),
 					resource.TestCheckResourceAttr(resourceName, "compute_environment_order.#", "3"),
@@ -304,6 +310,37 @@ func TestAccBatchJobQueue_state(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobQueue_forceDelete(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobQueue1 awstypes.JobQueueDetail
+	resourceName := "aws_batch_job_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobQueueConfig_forceDelete(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobQueueExists(ctx, resourceName, &jobQueue1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDelete, acctest.CtTrue),
+					// Leave a job in the queue so that destroying the queue must first terminate it.
+					testAccCheckJobQueueSubmitJob(ctx, resourceName, "aws_batch_job_definition.test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDelete},
+			},
+		},
+	})
+}
+
 func TestAccBatchJobQueue_jobStateTimeLimitActionsMultiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	var jobQueue1 awstypes.JobQueueDetail
@@ -424,6 +461,36 @@ func testAccCheckJobQueueDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckJobQueueSubmitJob(ctx context.Context, n, jobDefinitionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		jobDefinition, ok := s.RootModule().Resources[jobDefinitionName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", jobDefinitionName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchClient(ctx)
+
+		input := &batch.SubmitJobInput{
+			JobDefinition: aws.String(jobDefinition.Primary.Attributes[names.AttrARN]),
+			JobName:       aws.String(rs.Primary.Attributes[names.AttrName]),
+			JobQueue:      aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.SubmitJob(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error submitting job to Batch Job Queue (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
 // testAccCheckJobQueueComputeEnvironmentOrderUpdate simulates the change of a Compute Environment Order
 // An external update to the Batch Job Queue (e.g. console) may trigger changes to the value of the Order
 // parameter that do not affect the operation of the queue itself, but the resource logic needs to handle.
@@ -647,6 +714,35 @@ resource "aws_batch_job_queue" "test" {
 `, rName, state))
 }
 
+func testAccJobQueueConfig_forceDelete(rName string) string {
+	return acctest.ConfigCompose(
+		testAccJobQueueConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job_queue" "test" {
+  compute_environment_order {
+    compute_environment = aws_batch_compute_environment.test.arn
+    order               = 1
+  }
+
+  force_delete = true
+  name         = %[1]q
+  priority     = 1
+  state        = "ENABLED"
+}
+
+resource "aws_batch_job_definition" "test" {
+  container_properties = jsonencode({
+    command = ["sleep", "3600"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+  name = %[1]q
+  type = "container"
+}
+`, rName))
+}
+
 func testAccJobQueueConfig_ComputeEnvironments_multiple(rName string, state string) string {
 	return acctest.ConfigCompose(
 		testAccJobQueueConfig_base(rName),
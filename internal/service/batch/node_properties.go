@@ -23,6 +23,7 @@ type nodeRangeProperty struct {
 	Container     *containerProperties
 	EcsProperties *ecsProperties
 	EKSProperties *eksProperties
+	InstanceTypes []string
 	TargetNodes   *string
 }
 
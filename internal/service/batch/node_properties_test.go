@@ -196,6 +196,43 @@ func TestEquivalentNodePropertiesJSON(t *testing.T) {
 `,
 			wantEquivalent: true,
 		},
+		"Single node with changed instanceTypes": {
+			apiJSON: `
+{
+	"mainNode": 0,
+	"nodeRangeProperties": [
+		{
+			"container":
+			{
+				"image": "busybox",
+				"memory": 512
+			},
+			"instanceTypes": ["c5.large"],
+			"targetNodes": "0:"
+		}
+	],
+	"numNodes": 2
+}
+`,
+			configurationJSON: `
+{
+	"mainNode": 0,
+	"nodeRangeProperties": [
+		{
+			"container":
+			{
+				"image": "busybox",
+				"memory": 512
+			},
+			"instanceTypes": ["c5.xlarge"],
+			"targetNodes": "0:"
+		}
+	],
+	"numNodes": 2
+}
+`,
+			wantEquivalent: false,
+		},
 	}
 
 	for name, testCase := range testCases {
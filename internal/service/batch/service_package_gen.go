@@ -101,6 +101,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceConsumableResource,
+			TypeName: "aws_batch_consumable_resource",
+			Name:     "Consumable Resource",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceJobDefinition,
 			TypeName: "aws_batch_job_definition",
@@ -34,6 +34,15 @@ func RegisterSweepers() {
 		F: sweepComputeEnvironments,
 	})
 
+	resource.AddTestSweepers("aws_batch_consumable_resource", &resource.Sweeper{
+		Name: "aws_batch_consumable_resource",
+		F:    sweepConsumableResources,
+		Dependencies: []string{
+			"aws_batch_job_definition",
+			"aws_batch_job_queue",
+		},
+	})
+
 	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
@@ -168,6 +177,47 @@ func sweepComputeEnvironments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepConsumableResources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	input := &batch.ListConsumableResourcesInput{}
+	conn := client.BatchClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := batch.NewListConsumableResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Batch Consumable Resource sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Batch Consumable Resources (%s): %w", region, err)
+		}
+
+		for _, v := range page.ConsumableResources {
+			r := resourceConsumableResource()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ConsumableResourceArn))
+
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Batch Consumable Resources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepJobDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
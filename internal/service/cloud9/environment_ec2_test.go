@@ -104,6 +104,39 @@ func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 	})
 }
 
+// CONNECT_SSM environments require the AWSCloud9SSMAccessRole role and the
+// AWSCloud9SSMInstanceProfile instance profile, which have fixed names, so
+// this test cannot run in parallel.
+func TestAccCloud9EnvironmentEC2_connectionTypeSSM(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf types.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloud9_environment_ec2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.Cloud9EndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Cloud9ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentEC2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentEC2Config_connectionTypeSSM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentEC2Exists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "CONNECT_SSM"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", "amazonlinux-2023-x86_64"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrInstanceType, names.AttrSubnetID, "image_id"},
+			},
+		},
+	})
+}
+
 func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.Environment
@@ -271,6 +304,52 @@ resource "aws_iam_user" "test" {
 `, name, description, rName, imageID))
 }
 
+func testAccEnvironmentEC2Config_connectionTypeSSM(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentEC2Config_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "AWSCloud9SSMAccessRole"
+  path = "/service-role/"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "cloud9.${data.aws_partition.current.dns_suffix}",
+          "ec2.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSCloud9SSMInstanceProfile"
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "AWSCloud9SSMInstanceProfile"
+  path = "/cloud9/"
+  role = aws_iam_role.test.name
+}
+
+resource "aws_cloud9_environment_ec2" "test" {
+  instance_type   = "t2.micro"
+  name            = %[1]q
+  subnet_id       = aws_subnet.test[0].id
+  connection_type = "CONNECT_SSM"
+  image_id        = "amazonlinux-2023-x86_64"
+
+  depends_on = [aws_iam_instance_profile.test, aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
 func testAccEnvironmentEC2Config_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccEnvironmentEC2Config_base(rName), fmt.Sprintf(`
 resource "aws_cloud9_environment_ec2" "test" {
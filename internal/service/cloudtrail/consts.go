@@ -51,6 +51,20 @@ func field_Values() []string {
 	}
 }
 
+const (
+	eventCategoryData            = "Data"
+	eventCategoryManagement      = "Management"
+	eventCategoryNetworkActivity = "NetworkActivity"
+)
+
+func eventCategory_Values() []string {
+	return []string{
+		eventCategoryData,
+		eventCategoryManagement,
+		eventCategoryNetworkActivity,
+	}
+}
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
@@ -49,6 +51,8 @@ func resourceTrail() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customizeDiffAdvancedEventSelectors,
+
 		Schema: map[string]*schema.Schema{
 			"advanced_event_selector": {
 				Type:          schema.TypeList,
@@ -428,7 +432,7 @@ func resourceTrailRead(ctx context.Context, d *schema.ResourceData, meta any) di
 			return sdkdiag.AppendErrorf(diags, "setting event_selector")
 		}
 
-		if err := d.Set("advanced_event_selector", flattenAdvancedEventSelector(output.AdvancedEventSelectors)); err != nil {
+		if err := d.Set("advanced_event_selector", flattenAdvancedEventSelector(output.AdvancedEventSelectors, d.Get("advanced_event_selector").([]any))); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting advanced_event_selector")
 		}
 	}
@@ -823,13 +827,24 @@ func expandAdvancedEventSelectorFieldSelector(configured *schema.Set) []types.Ad
 	return fieldSelectors
 }
 
-func flattenAdvancedEventSelector(configured []types.AdvancedEventSelector) []map[string]any {
+// flattenAdvancedEventSelector flattens the API's advanced event selectors.
+// prior is the current value of the advanced_event_selector attribute and is used to preserve the configured order of field selector values.
+func flattenAdvancedEventSelector(configured []types.AdvancedEventSelector, prior []any) []map[string]any {
 	advancedEventSelectors := make([]map[string]any, 0, len(configured))
 
-	for _, raw := range configured {
+	for i, raw := range configured {
+		var priorFieldSelectors []any
+		if i < len(prior) {
+			if v, ok := prior[i].(map[string]any); ok {
+				if v, ok := v["field_selector"].(*schema.Set); ok {
+					priorFieldSelectors = v.List()
+				}
+			}
+		}
+
 		item := make(map[string]any)
 		item[names.AttrName] = aws.ToString(raw.Name)
-		item["field_selector"] = flattenAdvancedEventSelectorFieldSelector(raw.FieldSelectors)
+		item["field_selector"] = flattenAdvancedEventSelectorFieldSelector(raw.FieldSelectors, priorFieldSelectors)
 
 		advancedEventSelectors = append(advancedEventSelectors, item)
 	}
@@ -837,29 +852,38 @@ func flattenAdvancedEventSelector(configured []types.AdvancedEventSelector) []ma
 	return advancedEventSelectors
 }
 
-func flattenAdvancedEventSelectorFieldSelector(configured []types.AdvancedFieldSelector) []map[string]any {
+func flattenAdvancedEventSelectorFieldSelector(configured []types.AdvancedFieldSelector, prior []any) []map[string]any {
 	fieldSelectors := make([]map[string]any, 0, len(configured))
 
 	for _, raw := range configured {
+		field := aws.ToString(raw.Field)
+		priorItem := map[string]any{}
+		for _, v := range prior {
+			if v, ok := v.(map[string]any); ok && v[names.AttrField] == field {
+				priorItem = v
+				break
+			}
+		}
+
 		item := make(map[string]any)
-		item[names.AttrField] = aws.ToString(raw.Field)
+		item[names.AttrField] = field
 		if raw.Equals != nil {
-			item["equals"] = raw.Equals
+			item["equals"] = normalizeAdvancedFieldSelectorValues(priorItem["equals"], raw.Equals)
 		}
 		if raw.NotEquals != nil {
-			item["not_equals"] = raw.NotEquals
+			item["not_equals"] = normalizeAdvancedFieldSelectorValues(priorItem["not_equals"], raw.NotEquals)
 		}
 		if raw.StartsWith != nil {
-			item["starts_with"] = raw.StartsWith
+			item["starts_with"] = normalizeAdvancedFieldSelectorValues(priorItem["starts_with"], raw.StartsWith)
 		}
 		if raw.NotStartsWith != nil {
-			item["not_starts_with"] = raw.NotStartsWith
+			item["not_starts_with"] = normalizeAdvancedFieldSelectorValues(priorItem["not_starts_with"], raw.NotStartsWith)
 		}
 		if raw.EndsWith != nil {
-			item["ends_with"] = raw.EndsWith
+			item["ends_with"] = normalizeAdvancedFieldSelectorValues(priorItem["ends_with"], raw.EndsWith)
 		}
 		if raw.NotEndsWith != nil {
-			item["not_ends_with"] = raw.NotEndsWith
+			item["not_ends_with"] = normalizeAdvancedFieldSelectorValues(priorItem["not_ends_with"], raw.NotEndsWith)
 		}
 
 		fieldSelectors = append(fieldSelectors, item)
@@ -868,6 +892,92 @@ func flattenAdvancedEventSelectorFieldSelector(configured []types.AdvancedFieldS
 	return fieldSelectors
 }
 
+// normalizeAdvancedFieldSelectorValues returns the prior values if they contain the same elements as the API values.
+// The API does not guarantee the order of field selector values, which would otherwise cause perpetual diffs.
+func normalizeAdvancedFieldSelectorValues(prior any, values []string) []string {
+	v, ok := prior.([]any)
+	if !ok || len(v) != len(values) {
+		return values
+	}
+
+	old := flex.ExpandStringValueList(v)
+	a, b := slices.Clone(old), slices.Clone(values)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	if slices.Equal(a, b) {
+		return old
+	}
+
+	return values
+}
+
+var resourcesTypeRegexp = regexache.MustCompile(`^AWS::[0-9A-Za-z]+::[0-9A-Za-z]+$`)
+
+// customizeDiffAdvancedEventSelectors performs plan-time validation of advanced event selectors.
+func customizeDiffAdvancedEventSelectors(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	for i, tfMapRaw := range d.Get("advanced_event_selector").([]any) {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["field_selector"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		fields := make(map[string][]string)
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			field := tfMap[names.AttrField].(string)
+			var equals []string
+			if v, ok := tfMap["equals"].([]any); ok {
+				equals = flex.ExpandStringValueList(v)
+			}
+			fields[field] = equals
+
+			switch field {
+			case fieldEventCategory:
+				for _, v := range equals {
+					if v != "" && !slices.Contains(eventCategory_Values(), v) {
+						return fmt.Errorf("advanced_event_selector.%d: invalid %s value %q, expected one of %v", i, fieldEventCategory, v, eventCategory_Values())
+					}
+				}
+			case fieldResourcesType:
+				for _, v := range equals {
+					if v != "" && !resourcesTypeRegexp.MatchString(v) {
+						return fmt.Errorf("advanced_event_selector.%d: invalid %s value %q, expected a value of the form AWS::<service>::<resource>", i, fieldResourcesType, v)
+					}
+				}
+			}
+		}
+
+		categories, ok := fields[fieldEventCategory]
+		if !ok {
+			continue
+		}
+
+		if slices.Contains(categories, eventCategoryData) {
+			if _, ok := fields[fieldResourcesType]; !ok {
+				return fmt.Errorf("advanced_event_selector.%d: a %q field selector is required when %s is %q", i, fieldResourcesType, fieldEventCategory, eventCategoryData)
+			}
+		}
+
+		if slices.Contains(categories, eventCategoryNetworkActivity) {
+			if _, ok := fields[fieldEventSource]; !ok {
+				return fmt.Errorf("advanced_event_selector.%d: a %q field selector is required when %s is %q", i, fieldEventSource, fieldEventCategory, eventCategoryNetworkActivity)
+			}
+		}
+	}
+
+	return nil
+}
+
 func setInsightSelectors(ctx context.Context, conn *cloudtrail.Client, d *schema.ResourceData) error {
 	input := &cloudtrail.PutInsightSelectorsInput{
 		InsightSelectors: expandInsightSelector(d.Get("insight_selector").(*schema.Set).List()),
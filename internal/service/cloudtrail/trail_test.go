@@ -783,6 +783,73 @@ func testAccTrail_advancedEventSelector(t *testing.T) {
 	})
 }
 
+func testAccTrail_advancedEventSelectorNetworkActivity(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudtrail.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "networkActivityEvents"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.field_selector.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						names.AttrField: "eventCategory",
+						"equals.#":      "1",
+						"equals.0":      "NetworkActivity",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						names.AttrField: "errorCode",
+						"equals.#":      "1",
+						"equals.0":      "VpceAccessDenied",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.1.name", "s3ObjectLambdaAndSNSDataEvents"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.1.field_selector.*", map[string]string{
+						names.AttrField: "resources.type",
+						"equals.#":      "2",
+						"equals.0":      "AWS::SNS::Topic",
+						"equals.1":      "AWS::S3ObjectLambda::AccessPoint",
+					}),
+				),
+			},
+			{
+				Config:   testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTrail_advancedEventSelectorValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudTrailServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudTrailConfig_advancedEventSelectorMissingResourcesType(rName),
+				ExpectError: regexache.MustCompile(`a "resources.type" field selector is required when eventCategory is "Data"`),
+			},
+		},
+	})
+}
+
 func testAccTrail_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trail types.Trail
@@ -1635,6 +1702,69 @@ resource "aws_cloudtrail" "test" {
 `, rName))
 }
 
+func testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName string) string {
+	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.bucket
+
+  advanced_event_selector {
+    name = "networkActivityEvents"
+    field_selector {
+      field  = "eventCategory"
+      equals = ["NetworkActivity"]
+    }
+
+    field_selector {
+      field  = "eventSource"
+      equals = ["cloudtrail.amazonaws.com"]
+    }
+
+    field_selector {
+      field  = "errorCode"
+      equals = ["VpceAccessDenied"]
+    }
+  }
+
+  advanced_event_selector {
+    name = "s3ObjectLambdaAndSNSDataEvents"
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Data"]
+    }
+
+    field_selector {
+      field  = "resources.type"
+      equals = ["AWS::SNS::Topic", "AWS::S3ObjectLambda::AccessPoint"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCloudTrailConfig_advancedEventSelectorMissingResourcesType(rName string) string {
+	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.bucket
+
+  advanced_event_selector {
+    name = "dataEvents"
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Data"]
+    }
+  }
+}
+`, rName))
+}
+
 func testAccCloudTrailConfig_advancedEventSelector(rName string) string {
 	return acctest.ConfigCompose(testAccCloudTrailConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudtrail" "test" {
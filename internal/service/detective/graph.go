@@ -5,6 +5,7 @@ package detective
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,10 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/detective"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,6 +44,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.DatasourcePackage](),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,6 +85,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(aws.ToString(outputRaw.(*detective.CreateGraphOutput).GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
@@ -95,12 +114,41 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set(names.AttrCreatedTime, aws.ToTime(graph.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", graph.Arn)
 
+	datasourcePackages, err := findDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Graph (%s) datasource packages: %s", d.Id(), err)
+	}
+
+	// Only track the configured datasource packages. DETECTIVE_CORE, for example, is started by default.
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		datasourcePackages = tfslices.Filter(datasourcePackages, func(p string) bool {
+			return v.(*schema.Set).Contains(p)
+		})
+	}
+	d.Set("datasource_packages", datasourcePackages)
+
 	return diags
 }
 
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	// Tags only.
-	return resourceGraphRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveClient(ctx)
+
+	if d.HasChange("datasource_packages") {
+		// Datasource packages can be started but not stopped, so removed packages are no longer tracked.
+		o, n := d.GetChange("datasource_packages")
+		add := n.(*schema.Set).Difference(o.(*schema.Set))
+
+		if add.Len() > 0 {
+			if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](add)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
 func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -164,3 +212,51 @@ func findGraphs(ctx context.Context, conn *detective.Client, input *detective.Li
 
 	return output, nil
 }
+
+func updateDatasourcePackages(ctx context.Context, conn *detective.Client, graphARN string, datasourcePackages []awstypes.DatasourcePackage) error {
+	input := detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: datasourcePackages,
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackages(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("updating Detective Graph (%s) datasource packages: %w", graphARN, err)
+	}
+
+	return nil
+}
+
+// findDatasourcePackagesByGraphARN returns the datasource packages that have been started for the specified graph.
+func findDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Client, graphARN string) ([]string, error) {
+	input := detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+	var output []string
+
+	pages := detective.NewListDatasourcePackagesPaginator(conn, &input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range page.DatasourcePackages {
+			if v.DatasourcePackageIngestState == awstypes.DatasourcePackageIngestStateStarted {
+				output = append(output, k)
+			}
+		}
+	}
+
+	return output, nil
+}
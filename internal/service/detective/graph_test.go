@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -117,6 +118,65 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	ctx := acctest.Context(t)
+	var graph awstypes.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageEksAudit)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"datasource_packages"},
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT", "ASFF_SECURITYHUB_FINDING"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageEksAudit)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageAsffSecurityhubFinding)),
+				),
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"ASFF_SECURITYHUB_FINDING"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageAsffSecurityhubFinding)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGraphDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveClient(ctx)
@@ -170,6 +230,14 @@ resource "aws_detective_graph" "test" {}
 `
 }
 
+func testAccGraphConfig_datasourcePackages(datasourcePackages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, datasourcePackages)
+}
+
 func testAccGraphConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_detective_graph" "test" {
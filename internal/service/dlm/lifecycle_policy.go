@@ -160,6 +160,22 @@ func resourceLifecyclePolicy() *schema.Resource {
 								return false
 							},
 						},
+						"cross_region_copy_targets": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      3,
+							RequiredWith:  []string{"default_policy"},
+							ConflictsWith: []string{"policy_details.0.schedule"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"exclusions": {
 							Type:          schema.TypeList,
 							Optional:      true,
@@ -836,6 +852,9 @@ func expandPolicyDetails(tfList []any, defaultPolicyValue string) *awstypes.Poli
 		if v, ok := tfMap["create_interval"].(int); ok {
 			apiObject.CreateInterval = aws.Int32(int32(v))
 		}
+		if v, ok := tfMap["cross_region_copy_targets"].([]any); ok && len(v) > 0 {
+			apiObject.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v)
+		}
 		if v, ok := tfMap["exclusions"].([]any); ok && len(v) > 0 {
 			apiObject.Exclusions = expandExclusions(v)
 		}
@@ -883,6 +902,7 @@ func flattenPolicyDetails(apiObject *awstypes.PolicyDetails) []any {
 	tfMap[names.AttrAction] = flattenActions(apiObject.Actions)
 	tfMap["copy_tags"] = aws.ToBool(apiObject.CopyTags)
 	tfMap["create_interval"] = aws.ToInt32(apiObject.CreateInterval)
+	tfMap["cross_region_copy_targets"] = flattenCrossRegionCopyTargets(apiObject.CrossRegionCopyTargets)
 	tfMap["event_source"] = flattenEventSource(apiObject.EventSource)
 	tfMap["exclusions"] = flattenExclusions(apiObject.Exclusions)
 	tfMap["extend_deletion"] = aws.ToBool(apiObject.ExtendDeletion)
@@ -1657,6 +1677,39 @@ func flattenRetentionArchiveTier(apiObject *awstypes.RetentionArchiveTier) []any
 	return []any{tfMap}
 }
 
+func expandCrossRegionCopyTargets(tfList []any) []awstypes.CrossRegionCopyTarget {
+	apiObjects := make([]awstypes.CrossRegionCopyTarget, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.CrossRegionCopyTarget{}
+
+		if v, ok := tfMap["target_region"].(string); ok && v != "" {
+			apiObject.TargetRegion = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCrossRegionCopyTargets(apiObjects []awstypes.CrossRegionCopyTarget) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"target_region": aws.ToString(apiObject.TargetRegion),
+		})
+	}
+
+	return tfList
+}
+
 func expandExclusions(tfList []any) *awstypes.Exclusions {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
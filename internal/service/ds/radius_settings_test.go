@@ -29,7 +29,7 @@ func TestAccDSRadiusSettings_basic(t *testing.T) {
 	}
 
 	var v awstypes.RadiusSettings
-	resourceName := "aws_directory_service_region.test"
+	resourceName := "aws_directory_service_radius_settings.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	domainName := acctest.RandomDomainName()
 
@@ -77,7 +77,7 @@ func TestAccDSRadiusSettings_disappears(t *testing.T) {
 	}
 
 	var v awstypes.RadiusSettings
-	resourceName := "aws_directory_service_region.test"
+	resourceName := "aws_directory_service_radius_settings.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	domainName := acctest.RandomDomainName()
 
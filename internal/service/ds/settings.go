@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_directory_service_settings", name="Settings")
+func resourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSettingsPut,
+		ReadWithoutTimeout:   resourceSettingsRead,
+		UpdateWithoutTimeout: resourceSettingsPut,
+		DeleteWithoutTimeout: resourceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSClient(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+
+	// Directory settings cannot be removed, only changed.
+	if !d.IsNewResource() && d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		configured := expandSettingNames(expandSettings(n.(*schema.Set).List()))
+		for name := range expandSettingNames(expandSettings(o.(*schema.Set).List())) {
+			if _, ok := configured[name]; !ok {
+				log.Printf("[WARN] Directory Service Directory (%s) setting (%s) not reset, no longer managing it", directoryID, name)
+			}
+		}
+	}
+
+	settings := expandSettings(d.Get("setting").(*schema.Set).List())
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	_, err := conn.UpdateSettings(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Directory Service Directory (%s) settings: %s", directoryID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		d.SetId(directoryID)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitSettingsUpdated(ctx, conn, d.Id(), expandSettingNames(settings), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Directory (%s) settings update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSClient(ctx)
+
+	output, err := findSettingsByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) Settings not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+	}
+
+	// Only track the settings that are configured.
+	// On import, track the settings that have been changed from their defaults.
+	configured := expandSettingNames(expandSettings(d.Get("setting").(*schema.Set).List()))
+	var settings []awstypes.SettingEntry
+	for _, v := range output {
+		if len(configured) == 0 {
+			if v.RequestStatus != awstypes.DirectoryConfigurationStatusDefault {
+				settings = append(settings, v)
+			}
+		} else if _, ok := configured[aws.ToString(v.Name)]; ok {
+			settings = append(settings, v)
+		}
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", flattenSettingEntries(settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Directory settings cannot be removed, only changed.
+	log.Printf("[WARN] Directory Service Directory (%s) Settings not reset on delete, removing from state", d.Id())
+
+	return diags
+}
+
+func findSettingsByID(ctx context.Context, conn *directoryservice.Client, directoryID string) ([]awstypes.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []awstypes.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettings(ctx, input)
+
+		if errs.IsA[*awstypes.DirectoryDoesNotExistException](err) || errs.IsA[*awstypes.EntityDoesNotExistException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		output = append(output, page.SettingEntries...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusSettings(ctx context.Context, conn *directoryservice.Client, directoryID string, settingNames map[string]struct{}) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findSettingsByID(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Aggregate the request status of the settings being changed.
+		status := awstypes.DirectoryConfigurationStatusUpdated
+		var failures []error
+		for _, v := range output {
+			name := aws.ToString(v.Name)
+			if _, ok := settingNames[name]; !ok {
+				continue
+			}
+
+			switch v.RequestStatus {
+			case awstypes.DirectoryConfigurationStatusFailed:
+				failures = append(failures, fmt.Errorf("%s: %s", name, aws.ToString(v.RequestStatusMessage)))
+			case awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating:
+				status = awstypes.DirectoryConfigurationStatusUpdating
+			}
+		}
+
+		if len(failures) > 0 {
+			return output, string(awstypes.DirectoryConfigurationStatusFailed), errors.Join(failures...)
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.Client, directoryID string, settingNames map[string]struct{}, timeout time.Duration) ([]awstypes.SettingEntry, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating),
+		Target:  enum.Slice(awstypes.DirectoryConfigurationStatusUpdated),
+		Refresh: statusSettings(ctx, conn, directoryID, settingNames),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.SettingEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSettingNames(apiObjects []awstypes.Setting) map[string]struct{} {
+	settingNames := make(map[string]struct{}, len(apiObjects))
+
+	for _, v := range apiObjects {
+		settingNames[aws.ToString(v.Name)] = struct{}{}
+	}
+
+	return settingNames
+}
+
+func expandSettings(tfList []any) []awstypes.Setting {
+	var apiObjects []awstypes.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Setting{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSettingEntries(apiObjects []awstypes.SettingEntry) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		value := aws.ToString(apiObject.AppliedValue)
+		if value == "" {
+			value = aws.ToString(apiObject.RequestedValue)
+		}
+
+		tfList = append(tfList, map[string]any{
+			names.AttrName:  aws.ToString(apiObject.Name),
+			names.AttrValue: value,
+		})
+	}
+
+	return tfList
+}
@@ -47,6 +47,13 @@ func spotAllocationStrategy_Values() []string {
 	)
 }
 
+const (
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/security-group-connection-tracking.html#connection-tracking-timeouts
+	defaultConnectionTrackingTCPEstablishedTimeout = 432000
+	defaultConnectionTrackingUDPStreamTimeout      = 180
+	defaultConnectionTrackingUDPTimeout            = 30
+)
+
 const (
 	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-request-status.html#spot-instance-request-status-understand
 	spotInstanceRequestStatusCodeFulfilled          = "fulfilled"
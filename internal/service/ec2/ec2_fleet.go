@@ -68,6 +68,30 @@ func resourceFleet() *schema.Resource {
 				},
 				DiffSuppressOnRefresh: true,
 			},
+			"fleet_error_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"fleet_instance_set": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -824,6 +848,9 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set(names.AttrARN, fleetARN(ctx, c, d.Id()))
 	d.Set("context", fleet.Context)
 	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	if err := d.Set("fleet_error_set", flattenDescribeFleetErrors(fleet.Errors)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting fleet_error_set: %s", err)
+	}
 	if fleet.Instances != nil {
 		if err := d.Set("fleet_instance_set", flattenFleetInstanceSet(fleet.Instances)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting fleet_instance_set: %s", err)
@@ -1302,6 +1329,34 @@ func flattenCapacityReservationsOptions(apiObject *awstypes.CapacityReservationO
 	return tfMap
 }
 
+func flattenDescribeFleetError(apiObject awstypes.DescribeFleetError) map[string]any {
+	tfMap := map[string]any{
+		"error_code":    aws.ToString(apiObject.ErrorCode),
+		"error_message": aws.ToString(apiObject.ErrorMessage),
+		"lifecycle":     apiObject.Lifecycle,
+	}
+
+	if v := apiObject.LaunchTemplateAndOverrides; v != nil && v.Overrides != nil {
+		tfMap[names.AttrInstanceType] = v.Overrides.InstanceType
+	}
+
+	return tfMap
+}
+
+func flattenDescribeFleetErrors(apiObjects []awstypes.DescribeFleetError) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDescribeFleetError(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenFleetInstances(apiObject awstypes.DescribeFleetsInstances) map[string]any {
 	tfMap := map[string]any{}
 
@@ -3182,6 +3182,7 @@ func TestAccEC2Fleet_type_instant(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFleetExists(ctx, resourceName, &fleet1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, fleetType),
+					resource.TestCheckResourceAttr(resourceName, "fleet_error_set.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "fleet_instance_set.0.instance_ids.#", "2"),
 					resource.TestCheckResourceAttrSet(resourceName, "fleet_instance_set.0.instance_ids.0"),
@@ -66,6 +66,12 @@ func resourceHost() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"host_maintenance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.HostMaintenance](),
+			},
 			"host_recovery": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -114,6 +120,10 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.AssetIds = []string{v.(string)}
 	}
 
+	if v, ok := d.GetOk("host_maintenance"); ok {
+		input.HostMaintenance = awstypes.HostMaintenance(v.(string))
+	}
+
 	if v, ok := d.GetOk("instance_family"); ok {
 		input.InstanceFamily = aws.String(v.(string))
 	}
@@ -162,6 +172,7 @@ func resourceHostRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
 	d.Set(names.AttrAvailabilityZone, host.AvailabilityZone)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set(names.AttrInstanceType, host.HostProperties.InstanceType)
@@ -186,6 +197,10 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.AutoPlacement = awstypes.AutoPlacement(d.Get("auto_placement").(string))
 		}
 
+		if d.HasChange("host_maintenance") {
+			input.HostMaintenance = awstypes.HostMaintenance(d.Get("host_maintenance").(string))
+		}
+
 		if d.HasChange("host_recovery") {
 			input.HostRecovery = awstypes.HostRecovery(d.Get("host_recovery").(string))
 		}
@@ -95,6 +95,7 @@ func TestAccEC2Host_instanceFamily(t *testing.T) {
 					testAccCheckHostExists(ctx, resourceName, &host),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`dedicated-host/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "off"),
 					resource.TestCheckResourceAttr(resourceName, "host_recovery", "on"),
 					resource.TestCheckResourceAttr(resourceName, "instance_family", "c8g"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, ""),
@@ -114,6 +115,7 @@ func TestAccEC2Host_instanceFamily(t *testing.T) {
 					testAccCheckHostExists(ctx, resourceName, &host),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`dedicated-host/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "on"),
 					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
 					resource.TestCheckResourceAttr(resourceName, "instance_family", ""),
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "c8g.xlarge"),
@@ -288,6 +290,7 @@ func testAccHostConfig_instanceFamily(rName string) string {
 resource "aws_ec2_host" "test" {
   auto_placement    = "off"
   availability_zone = data.aws_availability_zones.available.names[0]
+  host_maintenance  = "off"
   host_recovery     = "on"
   instance_family   = "c8g"
 
@@ -303,6 +306,7 @@ func testAccHostConfig_instanceType(rName string) string {
 resource "aws_ec2_host" "test" {
   auto_placement    = "on"
   availability_zone = data.aws_availability_zones.available.names[0]
+  host_maintenance  = "on"
   host_recovery     = "off"
   instance_type     = "c8g.xlarge"
 
@@ -159,6 +159,33 @@ func resourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"connection_tracking_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tcp_established_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 432000),
+						},
+						"udp_stream_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 180),
+						},
+						"udp_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(30, 60),
+						},
+					},
+				},
+			},
 			"cpu_options": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -325,6 +352,35 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"ena_srd_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ena_srd_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"ena_srd_udp_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_udp_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"enclave_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1406,6 +1462,57 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.HasChanges("connection_tracking_specification", "ena_srd_specification") {
+		instance, err := findInstanceByID(ctx, conn, d.Id())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
+		}
+
+		var primaryInterface *awstypes.InstanceNetworkInterface
+		for _, ni := range instance.NetworkInterfaces {
+			if aws.ToInt32(ni.Attachment.DeviceIndex) == 0 {
+				primaryInterface = &ni
+			}
+		}
+
+		if primaryInterface == nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EC2 Instance (%s), which does not contain a primary network interface", d.Id())
+		}
+
+		// Only one network interface attribute can be modified per request.
+		if d.HasChange("connection_tracking_specification") {
+			input := ec2.ModifyNetworkInterfaceAttributeInput{
+				ConnectionTrackingSpecification: defaultConnectionTrackingSpecificationRequest(),
+				NetworkInterfaceId:              primaryInterface.NetworkInterfaceId,
+			}
+
+			if v, ok := d.GetOk("connection_tracking_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]any)[0].(map[string]any))
+			}
+
+			_, err = conn.ModifyNetworkInterfaceAttribute(ctx, &input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying EC2 Instance (%s) primary network interface connection tracking specification: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("ena_srd_specification") {
+			input := ec2.ModifyNetworkInterfaceAttributeInput{
+				EnaSrdSpecification: defaultEnaSrdSpecification(),
+				NetworkInterfaceId:  primaryInterface.NetworkInterfaceId,
+			}
+
+			if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]any)[0].(map[string]any))
+			}
+
+			_, err = conn.ModifyNetworkInterfaceAttribute(ctx, &input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying EC2 Instance (%s) primary network interface ENA Express specification: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("ipv6_address_count") && !d.IsNewResource() {
 		instance, err := findInstanceByID(ctx, conn, d.Id())
 		if err != nil {
@@ -2312,6 +2419,10 @@ func buildNetworkInterfaceOpts(d *schema.ResourceData, groups []string, nInterfa
 			ni.PrivateIpAddresses = expandSecondaryPrivateIPAddresses(v.(*schema.Set).List())
 		}
 
+		if v, ok := d.GetOk("enable_primary_ipv6"); ok {
+			ni.PrimaryIpv6 = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("ipv6_address_count"); ok {
 			ni.Ipv6AddressCount = aws.Int32(int32(v.(int)))
 		}
@@ -3251,6 +3362,24 @@ func resourceInstanceFlatten(ctx context.Context, client *conns.AWSClient, insta
 
 			rd.Set("associate_public_ip_address", primaryNetworkInterface.Association != nil)
 
+			// Default timeouts and disabled ENA Express are only reported if the block is already in state,
+			// so unconfigured blocks show no diff and explicitly configured ones aren't removed.
+			if v := primaryNetworkInterface.ConnectionTrackingConfiguration; v != nil && (!isDefaultConnectionTrackingTimeouts(v.TcpEstablishedTimeout, v.UdpStreamTimeout, v.UdpTimeout) || len(rd.Get("connection_tracking_specification").([]any)) > 0) {
+				if err := rd.Set("connection_tracking_specification", []any{flattenConnectionTrackingSpecificationResponse(v)}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting connection_tracking_specification: %s", err)
+				}
+			} else {
+				rd.Set("connection_tracking_specification", nil)
+			}
+
+			if v := primaryNetworkInterface.Attachment; v != nil && v.EnaSrdSpecification != nil && (aws.ToBool(v.EnaSrdSpecification.EnaSrdEnabled) || len(rd.Get("ena_srd_specification").([]any)) > 0) {
+				if err := rd.Set("ena_srd_specification", []any{flattenInstanceAttachmentEnaSrdSpecification(v.EnaSrdSpecification)}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+				}
+			} else {
+				rd.Set("ena_srd_specification", nil)
+			}
+
 			for _, address := range primaryNetworkInterface.PrivateIpAddresses {
 				if !aws.ToBool(address.Primary) {
 					secondaryPrivateIPs = append(secondaryPrivateIPs, aws.ToString(address.PrivateIpAddress))
@@ -3779,6 +3908,90 @@ func flattenInstanceMaintenanceOptions(apiObject *awstypes.InstanceMaintenanceOp
 	return tfMap
 }
 
+func flattenConnectionTrackingSpecificationResponse(apiObject *awstypes.ConnectionTrackingSpecificationResponse) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func expandEnaSrdSpecification(tfMap map[string]any) *awstypes.EnaSrdSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EnaSrdSpecification{
+		EnaSrdEnabled: aws.Bool(tfMap["ena_srd_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["ena_srd_udp_specification"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EnaSrdUdpSpecification = &awstypes.EnaSrdUdpSpecification{
+			EnaSrdUdpEnabled: aws.Bool(v[0].(map[string]any)["ena_srd_udp_enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+// defaultEnaSrdSpecification returns the ENA Express configuration that disables ENA Express.
+func defaultEnaSrdSpecification() *awstypes.EnaSrdSpecification {
+	return &awstypes.EnaSrdSpecification{
+		EnaSrdEnabled: aws.Bool(false),
+		EnaSrdUdpSpecification: &awstypes.EnaSrdUdpSpecification{
+			EnaSrdUdpEnabled: aws.Bool(false),
+		},
+	}
+}
+
+// defaultConnectionTrackingSpecificationRequest returns the connection tracking configuration that restores the default timeouts.
+func defaultConnectionTrackingSpecificationRequest() *awstypes.ConnectionTrackingSpecificationRequest {
+	return &awstypes.ConnectionTrackingSpecificationRequest{
+		TcpEstablishedTimeout: aws.Int32(defaultConnectionTrackingTCPEstablishedTimeout),
+		UdpStreamTimeout:      aws.Int32(defaultConnectionTrackingUDPStreamTimeout),
+		UdpTimeout:            aws.Int32(defaultConnectionTrackingUDPTimeout),
+	}
+}
+
+// isDefaultConnectionTrackingTimeouts returns whether every connection tracking timeout is unset or has its default value.
+func isDefaultConnectionTrackingTimeouts(tcpEstablishedTimeout, udpStreamTimeout, udpTimeout *int32) bool {
+	return (tcpEstablishedTimeout == nil || aws.ToInt32(tcpEstablishedTimeout) == defaultConnectionTrackingTCPEstablishedTimeout) &&
+		(udpStreamTimeout == nil || aws.ToInt32(udpStreamTimeout) == defaultConnectionTrackingUDPStreamTimeout) &&
+		(udpTimeout == nil || aws.ToInt32(udpTimeout) == defaultConnectionTrackingUDPTimeout)
+}
+
+func flattenInstanceAttachmentEnaSrdSpecification(apiObject *awstypes.InstanceAttachmentEnaSrdSpecification) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"ena_srd_enabled": aws.ToBool(apiObject.EnaSrdEnabled),
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []any{map[string]any{
+			"ena_srd_udp_enabled": aws.ToBool(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
+
 func expandPrivateDNSNameOptionsRequest(tfMap map[string]any) *awstypes.PrivateDnsNameOptionsRequest {
 	if tfMap == nil {
 		return nil
@@ -1295,6 +1295,101 @@ func TestAccEC2Instance_IPv6AddressCountAndSingleAddressCausesError(t *testing.T
 	})
 }
 
+func TestAccEC2Instance_connectionTrackingSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var original, updated awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_connectionTrackingSpecification(rName, 60, 60, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &original),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "30"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_connectionTrackingSpecification(rName, 3600, 120, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &updated),
+					testAccCheckInstanceNotRecreated(&original, &updated),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "60"),
+				),
+			},
+			{
+				// Explicit default timeouts are kept in state.
+				Config: testAccInstanceConfig_connectionTrackingSpecification(rName, 432000, 180, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &updated),
+					testAccCheckInstanceNotRecreated(&original, &updated),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "432000"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "180"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "30"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_connectionTrackingSpecificationRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &updated),
+					testAccCheckInstanceNotRecreated(&original, &updated),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_enaSRDSpecificationDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_enaSRDSpecificationDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ena_srd_specification.0.ena_srd_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_enaSRDSpecificationDisabled(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_IPv6_primaryEnable(t *testing.T) {
 	ctx := acctest.Context(t)
 	var original, updated awstypes.Instance
@@ -7552,6 +7647,70 @@ resource "aws_instance" "test" {
 `, primaryIPv6, rName))
 }
 
+func testAccInstanceConfig_connectionTrackingSpecification(rName string, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceConfig_vpcBase(rName, false, 0),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t3.micro", "t3a.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  connection_tracking_specification {
+    tcp_established_timeout = %[2]d
+    udp_stream_timeout      = %[3]d
+    udp_timeout             = %[4]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout))
+}
+
+func testAccInstanceConfig_connectionTrackingSpecificationRemoved(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceConfig_vpcBase(rName, false, 0),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t3.micro", "t3a.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceConfig_enaSRDSpecificationDisabled(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceConfig_vpcBase(rName, false, 0),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("aws_subnet.test.availability_zone", "t3.micro", "t3a.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id     = aws_subnet.test.id
+
+  ena_srd_specification {
+    ena_srd_enabled = false
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccInstanceConfig_ipv6Support(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
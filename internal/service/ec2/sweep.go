@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -66,11 +67,17 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
+	resource.AddTestSweepers("aws_ebs_fast_snapshot_restore", &resource.Sweeper{
+		Name: "aws_ebs_fast_snapshot_restore",
+		F:    sweepEBSFastSnapshotRestores,
+	})
+
 	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
 			"aws_ami",
+			"aws_ebs_fast_snapshot_restore",
 		},
 	})
 
@@ -740,6 +747,57 @@ func sweepEBSVolumes(region string) error {
 	return nil
 }
 
+func sweepEBSFastSnapshotRestores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.EC2Client(ctx)
+	input := ec2.DescribeFastSnapshotRestoresInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := ec2.NewDescribeFastSnapshotRestoresPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EBS Fast Snapshot Restore sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing EBS Fast Snapshot Restores (%s): %w", region, err)
+		}
+
+		for _, v := range page.FastSnapshotRestores {
+			if v.State == awstypes.FastSnapshotRestoreStateCodeDisabled || v.State == awstypes.FastSnapshotRestoreStateCodeDisabling {
+				continue
+			}
+
+			availabilityZone, snapshotID := aws.ToString(v.AvailabilityZone), aws.ToString(v.SnapshotId)
+			id, err := flex.FlattenResourceId([]string{availabilityZone, snapshotID}, ebsFastSnapshotRestoreIDPartCount, false)
+			if err != nil {
+				return err
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEBSFastSnapshotRestoreResource, client,
+				framework.NewAttribute(names.AttrAvailabilityZone, availabilityZone),
+				framework.NewAttribute(names.AttrID, id),
+				framework.NewAttribute(names.AttrSnapshotID, snapshotID),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EBS Fast Snapshot Restores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepEBSSnapshots(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -76,6 +76,33 @@ func resourceNetworkInterface() *schema.Resource {
 					},
 				},
 			},
+			"connection_tracking_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tcp_established_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 432000),
+						},
+						"udp_stream_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 180),
+						},
+						"udp_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(30, 60),
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -85,6 +112,36 @@ func resourceNetworkInterface() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ena_srd_specification": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"attachment"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ena_srd_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"ena_srd_udp_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ena_srd_udp_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"interface_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -358,6 +415,10 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		SubnetId:    aws.String(d.Get(names.AttrSubnetID).(string)),
 	}
 
+	if v, ok := d.GetOk("connection_tracking_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -505,6 +566,10 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]any)[0].(map[string]any))
+		}
+
 		_, err := attachNetworkInterface(ctx, conn, &input)
 
 		if err != nil {
@@ -543,7 +608,22 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		d.Set("attachment", nil)
 	}
+	// Default timeouts and disabled ENA Express are only reported if the block is already in state.
+	if v := eni.ConnectionTrackingConfiguration; v != nil && (!isDefaultConnectionTrackingTimeouts(v.TcpEstablishedTimeout, v.UdpStreamTimeout, v.UdpTimeout) || len(d.Get("connection_tracking_specification").([]any)) > 0) {
+		if err := d.Set("connection_tracking_specification", []any{flattenConnectionTrackingConfiguration(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting connection_tracking_specification: %s", err)
+		}
+	} else {
+		d.Set("connection_tracking_specification", nil)
+	}
 	d.Set(names.AttrDescription, eni.Description)
+	if v := eni.Attachment; v != nil && v.EnaSrdSpecification != nil && (aws.ToBool(v.EnaSrdSpecification.EnaSrdEnabled) || len(d.Get("ena_srd_specification").([]any)) > 0) {
+		if err := d.Set("ena_srd_specification", []any{flattenAttachmentEnaSrdSpecification(v.EnaSrdSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+		}
+	} else {
+		d.Set("ena_srd_specification", nil)
+	}
 	d.Set("interface_type", eni.InterfaceType)
 	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
@@ -617,10 +697,30 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 				}
 			}
 
+			if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]any)[0].(map[string]any))
+			}
+
 			if _, err := attachNetworkInterface(ctx, conn, &input); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
+	} else if d.HasChange("ena_srd_specification") {
+		// ENA Express is configured on the attachment, so it is applied above when the network interface is attached.
+		input := ec2.ModifyNetworkInterfaceAttributeInput{
+			EnaSrdSpecification: defaultEnaSrdSpecification(),
+			NetworkInterfaceId:  aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("ena_srd_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.EnaSrdSpecification = expandEnaSrdSpecification(v.([]any)[0].(map[string]any))
+		}
+
+		_, err := conn.ModifyNetworkInterfaceAttribute(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) ENA Express specification: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("private_ips") && !d.Get("private_ip_list_enabled").(bool) {
@@ -848,6 +948,23 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("connection_tracking_specification") {
+		input := ec2.ModifyNetworkInterfaceAttributeInput{
+			ConnectionTrackingSpecification: defaultConnectionTrackingSpecificationRequest(),
+			NetworkInterfaceId:              aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("connection_tracking_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]any)[0].(map[string]any))
+		}
+
+		_, err := conn.ModifyNetworkInterfaceAttribute(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) connection tracking specification: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("ipv6_addresses") && !d.Get("ipv6_address_list_enabled").(bool) {
 		o, n := d.GetChange("ipv6_addresses")
 		if o == nil {
@@ -1230,6 +1347,46 @@ func flattenNetworkInterfaceAttachment(apiObject *awstypes.NetworkInterfaceAttac
 	return tfMap
 }
 
+func flattenAttachmentEnaSrdSpecification(apiObject *awstypes.AttachmentEnaSrdSpecification) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"ena_srd_enabled": aws.ToBool(apiObject.EnaSrdEnabled),
+	}
+
+	if v := apiObject.EnaSrdUdpSpecification; v != nil {
+		tfMap["ena_srd_udp_specification"] = []any{map[string]any{
+			"ena_srd_udp_enabled": aws.ToBool(v.EnaSrdUdpEnabled),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenConnectionTrackingConfiguration(apiObject *awstypes.ConnectionTrackingConfiguration) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
 func expandPrivateIPAddressSpecification(tfString string) *awstypes.PrivateIpAddressSpecification {
 	if tfString == "" {
 		return nil
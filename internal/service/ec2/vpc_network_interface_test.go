@@ -141,6 +141,66 @@ func TestAccVPCNetworkInterface_ipv6Primary(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterface_connectionTrackingSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.NetworkInterface
+	resourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName, 60, 60, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "30"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_ip_list_enabled", "ipv6_address_list_enabled"},
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName, 3600, 120, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "60"),
+				),
+			},
+			{
+				// Explicit default timeouts are kept in state.
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName, 432000, 180, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "432000"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "180"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "30"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecificationRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInterface_ipv6PrimaryEnable(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.NetworkInterface
@@ -1387,6 +1447,40 @@ resource "aws_network_interface" "test" {
 `, enable, rName))
 }
 
+func testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName string, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout int) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV6(rName), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id       = aws_subnet.test.id
+  private_ips     = ["172.16.10.100"]
+  security_groups = [aws_security_group.test.id]
+
+  connection_tracking_specification {
+    tcp_established_timeout = %[2]d
+    udp_stream_timeout      = %[3]d
+    udp_timeout             = %[4]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout))
+}
+
+func testAccVPCNetworkInterfaceConfig_connectionTrackingSpecificationRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV6(rName), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id       = aws_subnet.test.id
+  private_ips     = ["172.16.10.100"]
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCNetworkInterfaceConfig_ipv6Multiple(rName string) string {
 	return acctest.ConfigCompose(testAccVPCNetworkInterfaceConfig_baseIPV6(rName), fmt.Sprintf(`
 resource "aws_network_interface" "test" {
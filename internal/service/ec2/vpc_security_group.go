@@ -33,6 +33,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Maximum number of values in a single DescribeSecurityGroupRules filter.
+	securityGroupRuleFilterValuesLimit = 200
+)
+
 // @SDKResource("aws_security_group", name="Security Group")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.SecurityGroup")
@@ -349,7 +354,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 
 	// conditionally revoke rules first before attempting to delete the group
 	if v := d.Get("revoke_rules_on_delete").(bool); v {
-		err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), false)
+		err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), true)
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -422,14 +427,14 @@ func securityGroupARN(ctx context.Context, c *conns.AWSClient, accountID, sgID s
 // forceRevokeSecurityGroupRules revokes all of the security group's ingress & egress rules
 // AND rules in other security groups that depend on this security group. Trying to delete
 // this security group with rules that originate in other groups but point here, will cause
-// a DepedencyViolation error. searchAll = true means to search every security group
-// looking for a rule depending on this security group. Otherwise, it will only look at
+// a DepedencyViolation error. searchReferencing = true means to also look up the security
+// groups whose rules reference this security group. Otherwise, it will only look at
 // groups that this group knows about.
-func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.Client, id string, searchAll bool) error {
+func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.Client, id string, searchReferencing bool) error {
 	conns.GlobalMutexKV.Lock(id)
 	defer conns.GlobalMutexKV.Unlock(id)
 
-	rules, err := rulesInSGsTouchingThis(ctx, conn, id, searchAll)
+	rules, err := rulesInSGsTouchingThis(ctx, conn, id, searchReferencing)
 	if err != nil {
 		return fmt.Errorf("describing security group rules: %w", err)
 	}
@@ -484,58 +489,93 @@ func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.Client, id str
 }
 
 // rulesInSGsTouchingThis finds all rules related to this group even if they live in
-// other groups. If searchAll = true, this could take a while as it looks through every
-// security group accessible from the account. This should only be used for troublesome
-// DependencyViolations.
-func rulesInSGsTouchingThis(ctx context.Context, conn *ec2.Client, id string, searchAll bool) ([]awstypes.SecurityGroupRule, error) {
-	var input *ec2.DescribeSecurityGroupRulesInput
-
-	if searchAll {
-		input = &ec2.DescribeSecurityGroupRulesInput{}
-	} else {
-		sgs, err := relatedSGs(ctx, conn, id)
+// other groups. If searchReferencing = true, the groups whose rules reference this group
+// are looked up using targeted filters rather than scanning every rule in the Region.
+func rulesInSGsTouchingThis(ctx context.Context, conn *ec2.Client, id string, searchReferencing bool) ([]awstypes.SecurityGroupRule, error) {
+	sgs, err := relatedSGs(ctx, conn, id)
+	if err != nil {
+		return nil, fmt.Errorf("describing security group rules: %w", err)
+	}
+
+	if searchReferencing {
+		referencing, err := referencingSGs(ctx, conn, id)
 		if err != nil {
 			return nil, fmt.Errorf("describing security group rules: %w", err)
 		}
 
-		input = &ec2.DescribeSecurityGroupRulesInput{
+		for _, v := range referencing {
+			if !slices.Contains(sgs, v) {
+				sgs = append(sgs, v)
+			}
+		}
+	}
+
+	rules := []awstypes.SecurityGroupRule{}
+
+	for chunk := range slices.Chunk(sgs, securityGroupRuleFilterValuesLimit) {
+		input := &ec2.DescribeSecurityGroupRulesInput{
 			Filters: []awstypes.Filter{
 				{
 					Name:   aws.String("group-id"),
-					Values: sgs,
+					Values: chunk,
 				},
 			},
 		}
-	}
 
-	rules := []awstypes.SecurityGroupRule{}
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-	pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("reading Security Group rules: %w", err)
+			}
 
-		if err != nil {
-			return nil, fmt.Errorf("reading Security Group rules: %w", err)
-		}
+			for _, rule := range page.SecurityGroupRules {
+				if rule.GroupId == nil {
+					continue
+				}
 
-		for _, rule := range page.SecurityGroupRules {
-			if rule.GroupId == nil {
-				continue
-			}
+				if aws.ToString(rule.GroupId) == id {
+					rules = append(rules, rule)
+					continue
+				}
 
-			if aws.ToString(rule.GroupId) == id {
-				rules = append(rules, rule)
-				continue
+				if rule.ReferencedGroupInfo != nil && rule.ReferencedGroupInfo.GroupId != nil && aws.ToString(rule.ReferencedGroupInfo.GroupId) == id {
+					rules = append(rules, rule)
+					continue
+				}
 			}
+		}
+	}
+
+	return rules, nil
+}
+
+// referencingSGs returns the IDs of the security groups in the Region with an ingress or
+// egress rule that references this group.
+func referencingSGs(ctx context.Context, conn *ec2.Client, id string) ([]string, error) {
+	var ids []string
+
+	for _, filterName := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		input := ec2.DescribeSecurityGroupsInput{
+			Filters: newAttributeFilterList(map[string]string{
+				filterName: id,
+			}),
+		}
 
-			if rule.ReferencedGroupInfo != nil && rule.ReferencedGroupInfo.GroupId != nil && aws.ToString(rule.ReferencedGroupInfo.GroupId) == id {
-				rules = append(rules, rule)
-				continue
+		sgs, err := findSecurityGroups(ctx, conn, &input)
+		if err != nil {
+			return nil, fmt.Errorf("reading Security Groups referencing (%s): %w", id, err)
+		}
+
+		for _, v := range sgs {
+			if v := aws.ToString(v.GroupId); v != id && !slices.Contains(ids, v) {
+				ids = append(ids, v)
 			}
 		}
 	}
 
-	return rules, nil
+	return ids, nil
 }
 
 // relatedSGs returns security group IDs of any other security group that is related
@@ -1502,6 +1502,41 @@ func TestAccVPCSecurityGroup_forceRevokeRulesTrue(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_forceRevokeRulesReferencing(t *testing.T) {
+	ctx := acctest.Context(t)
+	var primary awstypes.SecurityGroup
+	var secondary awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_security_group.primary"
+	resourceName2 := "aws_security_group.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Add a rule to the secondary group that references the primary group.
+			// The primary group has no rule pointing at the secondary group, so it
+			// must look up the groups that reference it to be able to delete.
+			{
+				Config: testAccVPCSecurityGroupConfig_revokeReferencing(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &primary),
+					testAccCheckSecurityGroupExists(ctx, resourceName2, &secondary),
+					testAddReferencingRule(ctx, &secondary, &primary),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupConfig_revokeReferencingPrimaryRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName2, &secondary),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_forceRevokeRulesFalse(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2758,6 +2793,25 @@ func testAddRuleCycle(ctx context.Context, primary, secondary *awstypes.Security
 	}
 }
 
+// testAddReferencingRule returns a TestCheckFunc that adds an ingress rule to the
+// referencing Security Group allowing traffic from the referenced Security Group.
+func testAddReferencingRule(ctx context.Context, referencing, referenced *awstypes.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       referencing.GroupId,
+			IpPermissions: []awstypes.IpPermission{cycleIPPermForGroup(aws.ToString(referenced.GroupId))},
+		}
+
+		if _, err := conn.AuthorizeSecurityGroupIngress(ctx, &input); err != nil {
+			return fmt.Errorf("authorizing Security Group (%s) ingress: %w", aws.ToString(referencing.GroupId), err)
+		}
+
+		return nil
+	}
+}
+
 // testRemoveRuleCycle removes the cyclic dependency between two security groups
 // that was added in testAddRuleCycle
 func testRemoveRuleCycle(ctx context.Context, primary, secondary *awstypes.SecurityGroup) resource.TestCheckFunc {
@@ -3275,6 +3329,48 @@ resource "aws_security_group" "secondary" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupConfig_revokeReferencingPrimaryRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "secondary" {
+  name   = "%[1]s-secondary"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  revoke_rules_on_delete = true
+}
+`, rName)
+}
+
+func testAccVPCSecurityGroupConfig_revokeReferencing(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupConfig_revokeReferencingPrimaryRemoved(rName), fmt.Sprintf(`
+resource "aws_security_group" "primary" {
+  name   = "%[1]s-primary"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  revoke_rules_on_delete = true
+
+  timeouts {
+    delete = "2m"
+  }
+}
+`, rName))
+}
+
 func testAccVPCSecurityGroupConfig_changed(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -13,6 +13,13 @@ import (
 const (
 	iamPropagationTimeout   = 2 * time.Minute
 	elbv2PropagationTimeout = 5 * time.Minute // nosemgrep:ci.elbv2-in-const-name, ci.elbv2-in-var-name
+
+	albNetworkInterfacesDetachTimeout = 35 * time.Minute // IPAM eventual consistency. It can take ~30 min to release allocations.
+	nlbNetworkInterfacesDetachTimeout = 5 * time.Minute
+)
+
+const (
+	describeTagsMaxResourceARNs = 20
 )
 
 // See https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_LoadBalancerAttribute.html#API_LoadBalancerAttribute_Contents.
@@ -7,6 +7,7 @@ package elbv2
 var (
 	ResourceListener              = resourceListener
 	ResourceListenerCertificate   = resourceListenerCertificate
+	ResourceListenerCertificates  = resourceListenerCertificates
 	ResourceListenerRule          = resourceListenerRule
 	ResourceLoadBalancer          = resourceLoadBalancer
 	ResourceTargetGroup           = resourceTargetGroup
@@ -14,20 +15,21 @@ var (
 	ResourceTrustStore            = resourceTrustStore
 	ResourceTrustStoreRevocation  = resourceTrustStoreRevocation
 
-	FindListenerByARN                    = findListenerByARN
-	FindListenerCertificateByTwoPartKey  = findListenerCertificateByTwoPartKey
-	FindListenerRuleByARN                = findListenerRuleByARN
-	FindLoadBalancerAttributesByARN      = findLoadBalancerAttributesByARN
-	FindLoadBalancerByARN                = findLoadBalancerByARN
-	FindTargetHealthDescription          = findTargetHealthDescription
-	FindTrustStoreByARN                  = findTrustStoreByARN
-	FindTrustStoreRevocationByTwoPartKey = findTrustStoreRevocationByTwoPartKey
-	HealthCheckProtocolEnumValues        = healthCheckProtocolEnumValues
-	HostedZoneIDPerRegionALBMap          = hostedZoneIDPerRegionALBMap
-	HostedZoneIDPerRegionNLBMap          = hostedZoneIDPerRegionNLBMap
-	ListenerARNFromRuleARN               = listenerARNFromRuleARN
-	ProtocolVersionEnumValues            = protocolVersionEnumValues
-	SuffixFromARN                        = suffixFromARN
+	FindListenerByARN                     = findListenerByARN
+	FindListenerCertificateByTwoPartKey   = findListenerCertificateByTwoPartKey
+	FindListenerCertificatesByListenerARN = findListenerCertificatesByListenerARN
+	FindListenerRuleByARN                 = findListenerRuleByARN
+	FindLoadBalancerAttributesByARN       = findLoadBalancerAttributesByARN
+	FindLoadBalancerByARN                 = findLoadBalancerByARN
+	FindTargetHealthDescription           = findTargetHealthDescription
+	FindTrustStoreByARN                   = findTrustStoreByARN
+	FindTrustStoreRevocationByTwoPartKey  = findTrustStoreRevocationByTwoPartKey
+	HealthCheckProtocolEnumValues         = healthCheckProtocolEnumValues
+	HostedZoneIDPerRegionALBMap           = hostedZoneIDPerRegionALBMap
+	HostedZoneIDPerRegionNLBMap           = hostedZoneIDPerRegionNLBMap
+	ListenerARNFromRuleARN                = listenerARNFromRuleARN
+	ProtocolVersionEnumValues             = protocolVersionEnumValues
+	SuffixFromARN                         = suffixFromARN
 )
 
 const (
@@ -69,7 +69,7 @@ func resourceListener() *schema.Resource {
 			names.AttrCertificateARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validCertificateARN,
 			},
 			names.AttrDefaultAction: {
 				Type:     schema.TypeList,
@@ -41,7 +41,7 @@ func resourceListenerCertificate() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validCertificateARN,
 			},
 			"listener_arn": {
 				Type:         schema.TypeString,
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lb_listener_certificates", name="Listener Certificates")
+func resourceListenerCertificates() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceListenerCertificatesCreate,
+		ReadWithoutTimeout:   resourceListenerCertificatesRead,
+		UpdateWithoutTimeout: resourceListenerCertificatesUpdate,
+		DeleteWithoutTimeout: resourceListenerCertificatesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validCertificateARN,
+				},
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceListenerCertificatesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	listenerARN := d.Get("listener_arn").(string)
+	certificateARNs := flex.ExpandStringValueSet(d.Get("certificate_arns").(*schema.Set))
+
+	if err := addListenerCertificates(ctx, conn, listenerARN, certificateARNs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ELBv2 Listener Certificates (%s): %s", listenerARN, err)
+	}
+
+	d.SetId(listenerARN)
+
+	if err := waitListenerCertificatesPropagated(ctx, conn, listenerARN, certificateARNs, nil); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Listener Certificates (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceListenerCertificatesRead(ctx, d, meta)...)
+}
+
+func resourceListenerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	certificates, err := findListenerCertificatesByListenerARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ELBv2 Listener Certificates (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener Certificates (%s): %s", d.Id(), err)
+	}
+
+	d.Set("certificate_arns", tfslices.ApplyToAll(certificates, func(v awstypes.Certificate) string {
+		return aws.ToString(v.CertificateArn)
+	}))
+	d.Set("listener_arn", d.Id())
+
+	return diags
+}
+
+func resourceListenerCertificatesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	if d.HasChange("certificate_arns") {
+		o, n := d.GetChange("certificate_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		// Add before removing so that clients negotiating SNI are never left without a matching certificate.
+		if err := addListenerCertificates(ctx, conn, d.Id(), add); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding ELBv2 Listener Certificates (%s): %s", d.Id(), err)
+		}
+
+		if err := removeListenerCertificates(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "removing ELBv2 Listener Certificates (%s): %s", d.Id(), err)
+		}
+
+		if err := waitListenerCertificatesPropagated(ctx, conn, d.Id(), add, del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Listener Certificates (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceListenerCertificatesRead(ctx, d, meta)...)
+}
+
+func resourceListenerCertificatesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	log.Printf("[INFO] Deleting ELBv2 Listener Certificates: %s", d.Id())
+	err := removeListenerCertificates(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("certificate_arns").(*schema.Set)))
+
+	if errs.IsA[*awstypes.ListenerNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Listener Certificates (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// addListenerCertificates adds the specified certificates to the listener.
+// AddListenerCertificates accepts only one certificate per call.
+func addListenerCertificates(ctx context.Context, conn *elasticloadbalancingv2.Client, listenerARN string, certificateARNs []string) error {
+	for _, certificateARN := range certificateARNs {
+		input := &elasticloadbalancingv2.AddListenerCertificatesInput{
+			Certificates: []awstypes.Certificate{{
+				CertificateArn: aws.String(certificateARN),
+			}},
+			ListenerArn: aws.String(listenerARN),
+		}
+
+		_, err := tfresource.RetryWhenIsA[any, *awstypes.CertificateNotFoundException](ctx, iamPropagationTimeout, func(ctx context.Context) (any, error) {
+			return conn.AddListenerCertificates(ctx, input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding certificate (%s): %w", certificateARN, err)
+		}
+	}
+
+	return nil
+}
+
+// removeListenerCertificates removes the specified certificates from the listener.
+// RemoveListenerCertificates accepts only one certificate per call.
+func removeListenerCertificates(ctx context.Context, conn *elasticloadbalancingv2.Client, listenerARN string, certificateARNs []string) error {
+	for _, certificateARN := range certificateARNs {
+		input := &elasticloadbalancingv2.RemoveListenerCertificatesInput{
+			Certificates: []awstypes.Certificate{{
+				CertificateArn: aws.String(certificateARN),
+			}},
+			ListenerArn: aws.String(listenerARN),
+		}
+
+		_, err := conn.RemoveListenerCertificates(ctx, input)
+
+		if errs.IsA[*awstypes.CertificateNotFoundException](err) {
+			continue
+		}
+
+		// Even though we're not trying to remove the default certificate, AWS started returning this error around 2023-12-09.
+		if errs.IsAErrorMessageContains[*awstypes.OperationNotPermittedException](err, "Default certificate cannot be removed") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing certificate (%s): %w", certificateARN, err)
+		}
+	}
+
+	return nil
+}
+
+func findListenerCertificatesByListenerARN(ctx context.Context, conn *elasticloadbalancingv2.Client, listenerARN string) ([]awstypes.Certificate, error) {
+	input := &elasticloadbalancingv2.DescribeListenerCertificatesInput{
+		ListenerArn: aws.String(listenerARN),
+		PageSize:    aws.Int32(400),
+	}
+
+	return findListenerCertificates(ctx, conn, input, func(v *awstypes.Certificate) bool {
+		return !aws.ToBool(v.IsDefault)
+	})
+}
+
+// waitListenerCertificatesPropagated waits until all added certificates are reported on the listener and all removed ones are not.
+func waitListenerCertificatesPropagated(ctx context.Context, conn *elasticloadbalancingv2.Client, listenerARN string, added, removed []string) error {
+	_, err := tfresource.RetryUntilEqual(ctx, elbv2PropagationTimeout, 0, func(ctx context.Context) (int, error) {
+		certificates, err := findListenerCertificatesByListenerARN(ctx, conn, listenerARN)
+
+		if err != nil {
+			return 0, err
+		}
+
+		current := make(map[string]struct{}, len(certificates))
+		for _, v := range certificates {
+			current[aws.ToString(v.CertificateArn)] = struct{}{}
+		}
+
+		var n int
+		for _, v := range added {
+			if _, ok := current[v]; !ok {
+				n++
+			}
+		}
+		for _, v := range removed {
+			if _, ok := current[v]; ok {
+				n++
+			}
+		}
+
+		return n, nil
+	})
+
+	return err
+}
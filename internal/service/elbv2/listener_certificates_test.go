@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2ListenerCertificates_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	keys, certificates := testAccListenerCertificatesKeysAndCertificates(t, 3)
+	lbListenerResourceName := "aws_lb_listener.test"
+	resourceName := "aws_lb_listener_certificates.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerCertificatesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerCertificatesConfig_basic(rName, keys, certificates, "additional_1", "additional_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerCertificatesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", lbListenerResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "certificate_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_2", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerCertificates_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	keys, certificates := testAccListenerCertificatesKeysAndCertificates(t, 3)
+	resourceName := "aws_lb_listener_certificates.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerCertificatesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerCertificatesConfig_basic(rName, keys, certificates, "additional_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerCertificatesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccListenerCertificatesConfig_basic(rName, keys, certificates, "additional_1", "additional_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerCertificatesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_2", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccListenerCertificatesConfig_basic(rName, keys, certificates, "additional_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerCertificatesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "certificate_arns.*", "aws_iam_server_certificate.additional_2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2ListenerCertificates_disappears_Listener(t *testing.T) {
+	ctx := acctest.Context(t)
+	keys, certificates := testAccListenerCertificatesKeysAndCertificates(t, 3)
+	resourceName := "aws_lb_listener_certificates.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerCertificatesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerCertificatesConfig_basic(rName, keys, certificates, "additional_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerCertificatesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelbv2.ResourceListener(), "aws_lb_listener.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccListenerCertificatesKeysAndCertificates(t *testing.T, n int) ([]string, []string) {
+	t.Helper()
+
+	keys := make([]string, n)
+	certificates := make([]string, n)
+	for i := range n {
+		keys[i] = acctest.TLSRSAPrivateKeyPEM(t, 2048)
+		certificates[i] = acctest.TLSRSAX509SelfSignedCertificatePEM(t, keys[i], "example.com")
+	}
+
+	return keys, certificates
+}
+
+func testAccCheckListenerCertificatesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lb_listener_certificates" {
+				continue
+			}
+
+			certificates, err := tfelbv2.FindListenerCertificatesByListenerARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(certificates) > 0 {
+				return fmt.Errorf("ELBv2 Listener Certificates %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckListenerCertificatesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+
+		certificates, err := tfelbv2.FindListenerCertificatesByListenerARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(certificates), rs.Primary.Attributes["certificate_arns.#"]; fmt.Sprint(got) != want {
+			return fmt.Errorf("ELBv2 Listener Certificates %s: got %d certificates, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccListenerCertificatesConfig_basic(rName string, keys, certificates []string, certificateNames ...string) string {
+	var arns []string
+	for _, v := range certificateNames {
+		arns = append(arns, fmt.Sprintf("aws_iam_server_certificate.%s.arn", v))
+	}
+
+	return acctest.ConfigCompose(testAccListenerCertificateConfig_base(rName, keys[0], certificates[0]), fmt.Sprintf(`
+resource "aws_iam_server_certificate" "additional_1" {
+  name             = "%[1]s-additional-1"
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+}
+
+resource "aws_iam_server_certificate" "additional_2" {
+  name             = "%[1]s-additional-2"
+  certificate_body = "%[4]s"
+  private_key      = "%[5]s"
+}
+
+resource "aws_lb_listener_certificates" "test" {
+  listener_arn     = aws_lb_listener.test.arn
+  certificate_arns = [%[6]s]
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificates[1]), acctest.TLSPEMEscapeNewlines(keys[1]), acctest.TLSPEMEscapeNewlines(certificates[2]), acctest.TLSPEMEscapeNewlines(keys[2]), strings.Join(arns, ", ")))
+}
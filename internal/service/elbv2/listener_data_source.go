@@ -5,6 +5,7 @@ package elbv2
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -300,6 +301,10 @@ func dataSourceListener() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"tcp_idle_timeout_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -351,5 +356,18 @@ func dataSourceListenerRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set(names.AttrProtocol, listener.Protocol)
 	d.Set("ssl_policy", listener.SslPolicy)
 
+	// tcp.idle_timeout.seconds is only supported on TCP and GENEVE listeners.
+	if slices.Contains(listenerAttributes["tcp_idle_timeout_seconds"].listenerTypesSupported, listener.Protocol) {
+		attributes, err := findListenerAttributesByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		}
+
+		listenerAttributeMap{
+			"tcp_idle_timeout_seconds": listenerAttributes["tcp_idle_timeout_seconds"],
+		}.flatten(d, attributes)
+	}
+
 	return diags
 }
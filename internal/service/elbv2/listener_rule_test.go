@@ -233,6 +233,11 @@ func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 			},
 			{
 				Config: testAccListenerRuleConfig_changeForwardWeightedStickiness(lbName, targetGroupName1, targetGroupName2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckListenerRuleExists(ctx, resourceName, &conf),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "elasticloadbalancing", regexache.MustCompile(fmt.Sprintf(`listener-rule/app/%s/.+$`, lbName))),
@@ -243,6 +248,12 @@ func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "action.0.type", "forward"),
 					resource.TestCheckResourceAttr(resourceName, "action.0.forward.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.target_group.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.0.forward.0.target_group.*", map[string]string{
+						names.AttrWeight: "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.0.forward.0.target_group.*", map[string]string{
+						names.AttrWeight: "80",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.stickiness.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.stickiness.0.duration", "3600"),
 					resource.TestCheckResourceAttr(resourceName, "action.0.redirect.#", "0"),
@@ -254,6 +265,11 @@ func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 			},
 			{
 				Config: testAccListenerRuleConfig_changeForwardWeightedToBasic(lbName, targetGroupName1, targetGroupName2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckListenerRuleExists(ctx, resourceName, &conf),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "elasticloadbalancing", regexache.MustCompile(fmt.Sprintf(`listener-rule/app/%s/.+$`, lbName))),
@@ -2749,12 +2765,12 @@ resource "aws_lb_listener_rule" "weighted" {
     forward {
       target_group {
         arn    = aws_lb_target_group.test1.arn
-        weight = 1
+        weight = 20
       }
 
       target_group {
         arn    = aws_lb_target_group.test2.arn
-        weight = 1
+        weight = 80
       }
 
       stickiness {
@@ -467,6 +467,11 @@ func TestAccELBV2Listener_Forward_weighted(t *testing.T) {
 			},
 			{
 				Config: testAccListenerConfig_Forward_changeWeightedStickiness(rName, rName2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckListenerExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttrPair(resourceName, "load_balancer_arn", "aws_lb.test", names.AttrARN),
@@ -477,6 +482,12 @@ func TestAccELBV2Listener_Forward_weighted(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.type", "forward"),
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.target_group.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_action.0.forward.0.target_group.*", map[string]string{
+						names.AttrWeight: "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_action.0.forward.0.target_group.*", map[string]string{
+						names.AttrWeight: "80",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.stickiness.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.forward.0.stickiness.0.duration", "3600"),
 					resource.TestCheckResourceAttr(resourceName, "default_action.0.redirect.#", "0"),
@@ -490,6 +501,11 @@ func TestAccELBV2Listener_Forward_weighted(t *testing.T) {
 			},
 			{
 				Config: testAccListenerConfig_Forward_changeWeightedToBasic(rName, rName2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckListenerExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttrPair(resourceName, "load_balancer_arn", "aws_lb.test", names.AttrARN),
@@ -2662,12 +2678,12 @@ resource "aws_lb_listener" "test" {
     forward {
       target_group {
         arn    = aws_lb_target_group.test1.arn
-        weight = 1
+        weight = 20
       }
 
       target_group {
         arn    = aws_lb_target_group.test2.arn
-        weight = 1
+        weight = 80
       }
 
       stickiness {
@@ -29,10 +29,12 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -42,6 +44,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 // @Tags(identifierAttribute="arn")
 // @ArnIdentity
 // @V60SDKv2Fix
+// @CustomImport
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types;awstypes;awstypes.LoadBalancer")
 func resourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
@@ -50,6 +53,10 @@ func resourceLoadBalancer() *schema.Resource {
 		UpdateWithoutTimeout: resourceLoadBalancerUpdate,
 		DeleteWithoutTimeout: resourceLoadBalancerDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLoadBalancerImport,
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffLoadBalancerALB,
 			customizeDiffLoadBalancerNLB,
@@ -104,6 +111,7 @@ func resourceLoadBalancer() *schema.Resource {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				Default:          3600,
+				ValidateFunc:     validation.IntBetween(60, 604800),
 				DiffSuppressFunc: suppressIfLBTypeNot(awstypes.LoadBalancerTypeEnumApplication),
 			},
 			"connection_logs": {
@@ -212,6 +220,11 @@ func resourceLoadBalancer() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.EnforceSecurityGroupInboundRulesOnPrivateLinkTrafficEnum](),
 				DiffSuppressFunc: suppressIfLBTypeNot(awstypes.LoadBalancerTypeEnumNetwork),
 			},
+			names.AttrForceDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"idle_timeout": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -341,10 +354,25 @@ func resourceLoadBalancer() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_group_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrVPCID: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"wait_for_connection_draining": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_network_interfaces": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"xff_header_processing_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -578,6 +606,19 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting subnets: %s", err)
 	}
 	d.Set(names.AttrVPCID, lb.VpcId)
+
+	// Record the attached target groups while the listeners that attach them still exist.
+	if d.Get("wait_for_connection_draining").(bool) {
+		targetGroupARNs, err := findLoadBalancerTargetGroupARNs(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Load Balancer (%s) target groups: %s", d.Id(), err)
+		}
+
+		d.Set("target_group_arns", targetGroupARNs)
+	} else {
+		d.Set("target_group_arns", nil)
+	}
 	d.Set("zone_id", lb.CanonicalHostedZoneId)
 
 	attributes, err := findLoadBalancerAttributesByARN(ctx, conn, d.Id())
@@ -753,6 +794,37 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, met
 		ipv4IPAMPoolID = aws.ToString(ipamPools.Ipv4IpamPoolId)
 	}
 
+	// All waits share the Delete timeout. IPAM pool allocations can take ~30 minutes to be released, so allow at least that long.
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if ipv4IPAMPoolID != "" {
+		timeout = max(timeout, albNetworkInterfacesDetachTimeout)
+	}
+	deadline := inttypes.NewDeadline(timeout)
+
+	if d.Get("wait_for_connection_draining").(bool) {
+		// Listeners managed in the same configuration have already been destroyed, detaching their target groups.
+		// Wait on the target groups recorded in state as well as any that are still attached.
+		targetGroupARNs, err := findLoadBalancerTargetGroupARNs(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Load Balancer (%s) target groups: %s", d.Id(), err)
+		}
+
+		targetGroupARNs = append(targetGroupARNs, flex.ExpandStringValueSet(d.Get("target_group_arns").(*schema.Set))...)
+		slices.Sort(targetGroupARNs)
+
+		if err := waitForLoadBalancerTargetsToDrain(ctx, conn, slices.Compact(targetGroupARNs), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Load Balancer (%s) targets to drain: %s", d.Id(), err)
+		}
+	}
+
+	// DeleteLoadBalancer also deletes any remaining listeners.
+	if !d.Get(names.AttrForceDestroy).(bool) {
+		if err := checkLoadBalancerListeners(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ELBv2 Load Balancer (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting ELBv2 Load Balancer: %s", d.Id())
 	_, err := conn.DeleteLoadBalancer(ctx, &elasticloadbalancingv2.DeleteLoadBalancerInput{
 		LoadBalancerArn: aws.String(d.Id()),
@@ -764,17 +836,54 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, met
 
 	ec2conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	if err := waitForALBNetworkInterfacesToDetach(ctx, ec2conn, d.Id(), ipv4IPAMPoolID); err != nil {
+	// By default ENI cleanup is best effort. When wait_for_network_interfaces is set, wait up to the Delete timeout and surface any failure.
+	if d.Get("wait_for_network_interfaces").(bool) {
+		if err := waitForALBNetworkInterfacesToDetach(ctx, ec2conn, d.Id(), ipv4IPAMPoolID, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Load Balancer (%s) ALB network interfaces detach: %s", d.Id(), err)
+		}
+
+		if err := waitForNLBNetworkInterfacesToDetach(ctx, ec2conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ELBv2 Load Balancer (%s) NLB network interfaces detach: %s", d.Id(), err)
+		}
+
+		return diags
+	}
+
+	if err := waitForALBNetworkInterfacesToDetach(ctx, ec2conn, d.Id(), ipv4IPAMPoolID, albNetworkInterfacesDetachTimeout); err != nil {
 		log.Printf("[WARN] Failed to wait for ENIs to disappear for ALB (%s): %s", d.Id(), err)
 	}
 
-	if err := waitForNLBNetworkInterfacesToDetach(ctx, ec2conn, d.Id()); err != nil {
+	if err := waitForNLBNetworkInterfacesToDetach(ctx, ec2conn, d.Id(), nlbNetworkInterfacesDetachTimeout); err != nil {
 		log.Printf("[WARN] Failed to wait for ENIs to disappear for NLB (%s): %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+func resourceLoadBalancerImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// Load balancers can also be imported by name.
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+		lb, err := findLoadBalancerByName(ctx, conn, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading ELBv2 Load Balancer (%s): %w", id, err)
+		}
+
+		d.SetId(aws.ToString(lb.LoadBalancerArn))
+	}
+
+	d.Set(names.AttrForceDestroy, true)
+
+	identitySpec := importer.IdentitySpec(ctx)
+	if err := importer.RegionalARN(ctx, d, identitySpec); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func modifyLoadBalancerAttributes(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, attributes []awstypes.LoadBalancerAttribute) error {
 	input := elasticloadbalancingv2.ModifyLoadBalancerAttributesInput{
 		Attributes:      attributes,
@@ -1035,6 +1144,27 @@ func findLoadBalancerByARN(ctx context.Context, conn *elasticloadbalancingv2.Cli
 	return output, nil
 }
 
+func findLoadBalancerByName(ctx context.Context, conn *elasticloadbalancingv2.Client, name string) (*awstypes.LoadBalancer, error) {
+	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{
+		Names: []string{name},
+	}
+
+	output, err := findLoadBalancer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.LoadBalancerName) != name {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findLoadBalancerAttributesByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) ([]awstypes.LoadBalancerAttribute, error) {
 	input := elasticloadbalancingv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: aws.String(arn),
@@ -1145,15 +1275,84 @@ func waitLoadBalancerActive(ctx context.Context, conn *elasticloadbalancingv2.Cl
 	return nil, err
 }
 
-func waitForALBNetworkInterfacesToDetach(ctx context.Context, conn *ec2.Client, arn, ipv4IPAMPoolID string) error {
+// checkLoadBalancerListeners returns an error if any listeners are still attached to the load balancer.
+// Listeners managed in the same configuration are destroyed before the load balancer, so any
+// remaining listeners were created outside of it.
+func checkLoadBalancerListeners(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) error {
+	input := elasticloadbalancingv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(arn),
+	}
+	listeners, err := findListeners(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.Listener]())
+
+	if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading listeners: %w", err)
+	}
+
+	if len(listeners) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d listener(s) still attached; set %s to delete them with the load balancer", len(listeners), names.AttrForceDestroy)
+}
+
+func findLoadBalancerTargetGroupARNs(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) ([]string, error) {
+	input := &elasticloadbalancingv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(arn),
+	}
+	targetGroups, err := findTargetGroups(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(targetGroups, func(v awstypes.TargetGroup) string {
+		return aws.ToString(v.TargetGroupArn)
+	}), nil
+}
+
+// waitForLoadBalancerTargetsToDrain waits until none of the specified target groups report any draining targets.
+func waitForLoadBalancerTargetsToDrain(ctx context.Context, conn *elasticloadbalancingv2.Client, targetGroupARNs []string, timeout time.Duration) error {
+	_, err := tfresource.RetryUntilEqual(ctx, timeout, 0, func(ctx context.Context) (int, error) {
+		var n int
+		for _, arn := range targetGroupARNs {
+			input := &elasticloadbalancingv2.DescribeTargetHealthInput{
+				TargetGroupArn: aws.String(arn),
+			}
+			targets, err := findTargetHealthDescriptions(ctx, conn, input, func(v *awstypes.TargetHealthDescription) bool {
+				return v.TargetHealth != nil && v.TargetHealth.State == awstypes.TargetHealthStateEnumDraining
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return 0, err
+			}
+
+			n += len(targets)
+		}
+
+		return n, nil
+	})
+
+	return err
+}
+
+func waitForALBNetworkInterfacesToDetach(ctx context.Context, conn *ec2.Client, arn, ipv4IPAMPoolID string, timeout time.Duration) error {
 	name, err := loadBalancerNameFromARN(arn)
 	if err != nil {
 		return err
 	}
 
-	const (
-		timeout = 35 * time.Minute // IPAM eventual consistency. It can take ~30 min to release allocations.
-	)
 	_, err = tfresource.RetryUntilEqual(ctx, timeout, 0, func(ctx context.Context) (int, error) {
 		networkInterfaces, err := tfec2.FindNetworkInterfacesByAttachmentInstanceOwnerIDAndDescription(ctx, conn, "amazon-elb", "ELB "+name)
 		if err != nil {
@@ -1189,15 +1388,12 @@ func waitForALBNetworkInterfacesToDetach(ctx context.Context, conn *ec2.Client,
 	return err
 }
 
-func waitForNLBNetworkInterfacesToDetach(ctx context.Context, conn *ec2.Client, lbArn string) error {
+func waitForNLBNetworkInterfacesToDetach(ctx context.Context, conn *ec2.Client, lbArn string, timeout time.Duration) error {
 	name, err := loadBalancerNameFromARN(lbArn)
 	if err != nil {
 		return err
 	}
 
-	const (
-		timeout = 5 * time.Minute
-	)
 	_, err = tfresource.RetryUntilEqual(ctx, timeout, 0, func(ctx context.Context) (int, error) {
 		networkInterfaces, err := tfec2.FindNetworkInterfacesByAttachmentInstanceOwnerIDAndDescription(ctx, conn, "amazon-aws", "ELB "+name)
 		if err != nil {
@@ -1229,6 +1425,61 @@ func waitCapacityReservationProvisioned(ctx context.Context, conn *elasticloadba
 	return nil, err
 }
 
+// filterLoadBalancersByTags returns the load balancers whose tags contain all of the specified tags.
+// Tags are read in batches of up to describeTagsMaxResourceARNs load balancers.
+func filterLoadBalancersByTags(ctx context.Context, conn *elasticloadbalancingv2.Client, loadBalancers []awstypes.LoadBalancer, tagsToMatch tftags.KeyValueTags) ([]awstypes.LoadBalancer, error) {
+	var output []awstypes.LoadBalancer
+
+	for chunk := range slices.Chunk(loadBalancers, describeTagsMaxResourceARNs) {
+		input := elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: tfslices.ApplyToAll(chunk, func(v awstypes.LoadBalancer) string {
+				return aws.ToString(v.LoadBalancerArn)
+			}),
+		}
+
+		page, err := conn.DescribeTags(ctx, &input)
+
+		// A load balancer in the batch may have been deleted since it was described.
+		if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
+			for _, v := range chunk {
+				arn := aws.ToString(v.LoadBalancerArn)
+				tags, err := listTags(ctx, conn, arn)
+
+				if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
+					continue
+				}
+
+				if err != nil {
+					return nil, fmt.Errorf("listing tags for (%s): %w", arn, err)
+				}
+
+				if tags.ContainsAll(tagsToMatch) {
+					output = append(output, v)
+				}
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		tagsByARN := make(map[string]tftags.KeyValueTags, len(page.TagDescriptions))
+		for _, v := range page.TagDescriptions {
+			tagsByARN[aws.ToString(v.ResourceArn)] = keyValueTags(ctx, v.Tags)
+		}
+
+		for _, v := range chunk {
+			if tags, ok := tagsByARN[aws.ToString(v.LoadBalancerArn)]; ok && tags.ContainsAll(tagsToMatch) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
 func loadBalancerNameFromARN(s string) (string, error) {
 	v, err := arn.Parse(s)
 	if err != nil {
@@ -5,6 +5,7 @@ package elbv2
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,8 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -256,28 +257,19 @@ func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if len(tagsToMatch) > 0 {
-		var loadBalancers []awstypes.LoadBalancer
+		results, err = filterLoadBalancersByTags(ctx, conn, results, tagsToMatch)
 
-		for _, loadBalancer := range results {
-			arn := aws.ToString(loadBalancer.LoadBalancerArn)
-			tags, err := listTags(ctx, conn, arn)
-
-			if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
-				continue
-			}
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "listing tags for (%s): %s", arn, err)
-			}
-
-			if !tags.ContainsAll(tagsToMatch) {
-				continue
-			}
-
-			loadBalancers = append(loadBalancers, loadBalancer)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Load Balancers tags: %s", err)
 		}
+	}
+
+	if len(results) > 1 && len(tagsToMatch) > 0 {
+		arns := tfslices.ApplyToAll(results, func(v awstypes.LoadBalancer) string {
+			return aws.ToString(v.LoadBalancerArn)
+		})
 
-		results = loadBalancers
+		return sdkdiag.AppendErrorf(diags, "Search returned %d results matching tags (%s), please revise so only one is returned", len(results), strings.Join(arns, ", "))
 	}
 
 	if len(results) != 1 {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -95,6 +96,23 @@ func TestAccELBV2LoadBalancerDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccELBV2LoadBalancerDataSource_tagsAmbiguous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerDataSourceConfig_tagsAmbiguous(rName),
+				ExpectError: regexache.MustCompile(`Search returned 2 results matching tags`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2LoadBalancerDataSource_outpost(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -294,6 +312,30 @@ data "aws_lb" "alb_test_with_tags" {
 `, rName))
 }
 
+func testAccLoadBalancerDataSourceConfig_tagsAmbiguous(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  count = 2
+
+  name     = "${substr(%[1]q, 0, 30)}-${count.index}"
+  internal = true
+  subnets  = aws_subnet.test[*].id
+
+  tags = {
+    Config = %[1]q
+  }
+}
+
+data "aws_lb" "test" {
+  tags = {
+    Config = %[1]q
+  }
+
+  depends_on = [aws_lb.test]
+}
+`, rName))
+}
+
 func testAccLoadBalancerDataSourceConfig_outpost(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
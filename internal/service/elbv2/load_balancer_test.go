@@ -218,6 +218,40 @@ func TestAccELBV2LoadBalancer_disappears(t *testing.T) {
 	})
 }
 
+func TestAccELBV2LoadBalancer_importByName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.LoadBalancer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"drop_invalid_header_fields",
+					"enable_http2",
+					"enable_waf_fail_open",
+					"idle_timeout",
+				},
+			},
+		},
+	})
+}
+
 func TestAccELBV2LoadBalancer_nameGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.LoadBalancer
@@ -779,6 +813,136 @@ func TestAccELBV2LoadBalancer_ApplicationLoadBalancer_clientKeepAlive(t *testing
 	})
 }
 
+func TestAccELBV2LoadBalancer_NetworkLoadBalancer_waitForNetworkInterfaces(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pre, post awstypes.LoadBalancer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_nlbWaitForNetworkInterfaces(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &pre),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_network_interfaces", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccLoadBalancerConfig_nlbWaitForNetworkInterfaces(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &post),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_network_interfaces", acctest.CtTrue),
+					testAccCheckLoadBalancerNotRecreated(&pre, &post),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2LoadBalancer_NetworkLoadBalancer_waitForConnectionDraining(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.LoadBalancer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_nlbWaitForConnectionDraining(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_connection_draining", acctest.CtTrue),
+				),
+			},
+			{
+				// The listener is created after the load balancer, so its target group is recorded on refresh.
+				Config: testAccLoadBalancerConfig_nlbWaitForConnectionDraining(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_group_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_group_arns.*", "aws_lb_target_group.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target_group_arns", "wait_for_connection_draining"},
+			},
+		},
+	})
+}
+
+func TestAccELBV2LoadBalancer_forceDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pre, post awstypes.LoadBalancer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig_forceDestroy(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &pre),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDestroy, acctest.CtFalse),
+					testAccCheckLoadBalancerCreateListener(ctx, &pre),
+				),
+			},
+			{
+				Config:      testAccLoadBalancerConfig_baseInternal(rName, 2),
+				ExpectError: regexache.MustCompile(`listener\(s\) still attached`),
+			},
+			{
+				Config: testAccLoadBalancerConfig_forceDestroy(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLoadBalancerExists(ctx, resourceName, &post),
+					resource.TestCheckResourceAttr(resourceName, names.AttrForceDestroy, acctest.CtTrue),
+					testAccCheckLoadBalancerNotRecreated(&pre, &post),
+				),
+			},
+			{
+				Config: testAccLoadBalancerConfig_baseInternal(rName, 2),
+			},
+		},
+	})
+}
+
+func TestAccELBV2LoadBalancer_ApplicationLoadBalancer_clientKeepAliveInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoadBalancerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerConfig_clientKeepAlive(rName, 59),
+				ExpectError: regexache.MustCompile(`expected client_keep_alive to be in the range \(60 - 604800\)`),
+			},
+			{
+				Config:      testAccLoadBalancerConfig_clientKeepAlive(rName, 604801),
+				ExpectError: regexache.MustCompile(`expected client_keep_alive to be in the range \(60 - 604800\)`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2LoadBalancer_ApplicationLoadBalancer_updateDropInvalidHeaderFields(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pre, mid, post awstypes.LoadBalancer
@@ -2335,6 +2499,30 @@ func testAccCheckLoadBalancerNotRecreated(i, j *awstypes.LoadBalancer) resource.
 	}
 }
 
+// testAccCheckLoadBalancerCreateListener creates a listener outside of Terraform's control.
+func testAccCheckLoadBalancerCreateListener(ctx context.Context, v *awstypes.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+
+		input := &elasticloadbalancingv2.CreateListenerInput{
+			DefaultActions: []awstypes.Action{{
+				FixedResponseConfig: &awstypes.FixedResponseActionConfig{
+					ContentType: aws.String("text/plain"),
+					StatusCode:  aws.String("200"),
+				},
+				Type: awstypes.ActionTypeEnumFixedResponse,
+			}},
+			LoadBalancerArn: v.LoadBalancerArn,
+			Port:            aws.Int32(80),
+			Protocol:        awstypes.ProtocolEnumHttp,
+		}
+
+		_, err := conn.CreateListener(ctx, input)
+
+		return err
+	}
+}
+
 func testAccCheckLoadBalancerRecreated(i, j *awstypes.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.ToString(i.LoadBalancerArn) == aws.ToString(j.LoadBalancerArn) {
@@ -2967,6 +3155,88 @@ resource "aws_lb" "test" {
 `, rName, value))
 }
 
+func testAccLoadBalancerConfig_forceDestroy(rName string, forceDestroy bool) string {
+	return acctest.ConfigCompose(testAccLoadBalancerConfig_baseInternal(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name            = %[1]q
+  internal        = true
+  security_groups = [aws_security_group.test.id]
+  subnets         = aws_subnet.test[*].id
+
+  force_destroy = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, forceDestroy))
+}
+
+func testAccLoadBalancerConfig_nlbWaitForNetworkInterfaces(rName string, wait bool) string {
+	return acctest.ConfigCompose(testAccLoadBalancerConfig_baseInternal(rName, 1), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+
+  wait_for_network_interfaces = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+
+  timeouts {
+    delete = "15m"
+  }
+}
+`, rName, wait))
+}
+
+func testAccLoadBalancerConfig_nlbWaitForConnectionDraining(rName string) string {
+	return acctest.ConfigCompose(testAccLoadBalancerConfig_baseInternal(rName, 1), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+
+  wait_for_connection_draining = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name        = %[1]q
+  port        = 80
+  protocol    = "TCP"
+  target_type = "ip"
+  vpc_id      = aws_vpc.test.id
+
+  deregistration_delay = 5
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.arn
+  port              = 80
+  protocol          = "TCP"
+
+  default_action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+}
+
+resource "aws_lb_target_group_attachment" "test" {
+  target_group_arn = aws_lb_target_group.test.arn
+  target_id        = cidrhost(aws_subnet.test[0].cidr_block, 10)
+  port             = 80
+}
+`, rName))
+}
+
 func testAccLoadBalancerConfig_enableDropInvalidHeaderFields(rName string, dropInvalid bool) string {
 	return acctest.ConfigCompose(testAccLoadBalancerConfig_baseInternal(rName, 2), fmt.Sprintf(`
 resource "aws_lb" "test" {
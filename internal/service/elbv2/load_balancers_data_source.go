@@ -8,12 +8,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
-	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -52,27 +50,11 @@ func dataSourceLoadBalancersRead(ctx context.Context, d *schema.ResourceData, me
 
 	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	if len(tagsToMatch) > 0 {
-		var loadBalancers []awstypes.LoadBalancer
+		results, err = filterLoadBalancersByTags(ctx, conn, results, tagsToMatch)
 
-		for _, loadBalancer := range results {
-			arn := aws.ToString(loadBalancer.LoadBalancerArn)
-			tags, err := listTags(ctx, conn, arn)
-
-			if errs.IsA[*awstypes.LoadBalancerNotFoundException](err) {
-				continue
-			}
-
-			if err != nil {
-				return create.AppendDiagError(diags, names.ELBV2, "listing tags", DSNameLoadBalancers, arn, err)
-			}
-			if !tags.ContainsAll(tagsToMatch) {
-				continue
-			}
-
-			loadBalancers = append(loadBalancers, loadBalancer)
+		if err != nil {
+			return create.AppendDiagError(diags, names.ELBV2, "listing tags", DSNameLoadBalancers, "", err)
 		}
-
-		results = loadBalancers
 	}
 
 	var loadBalancerARNs []string
@@ -9,6 +9,14 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validCertificateARN validates that a listener certificate is an ACM certificate or an IAM server certificate ARN.
+var validCertificateARN = validation.Any(
+	verify.ValidARNOfService("acm", "certificate/"),
+	verify.ValidARNOfService("iam", "server-certificate/"),
 )
 
 func validName(v any, k string) (ws []string, errors []error) {
@@ -128,3 +128,32 @@ func TestValidTargetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidCertificateARN(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:server-certificate/test_cert",                              // lintignore:AWSAT005
+	}
+
+	for _, s := range validNames {
+		_, errors := validCertificateARN(s, names.AttrCertificateARN)
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid certificate ARN: %v", s, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"12345678-1234-1234-1234-123456789012",
+		"arn:aws:iam::123456789012:role/test_role",                                                          // lintignore:AWSAT005
+		"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", // lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, s := range invalidNames {
+		_, errors := validCertificateARN(s, names.AttrCertificateARN)
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid certificate ARN: %v", s, errors)
+		}
+	}
+}
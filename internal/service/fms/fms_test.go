@@ -27,6 +27,7 @@ func TestAccFMS_serial(t *testing.T) {
 			"resourceTags":               testAccPolicy_resourceTags,
 			"resourceTagLogicalOperator": testAccPolicy_resourceTagLogicalOperator,
 			"securityGroup":              testAccPolicy_securityGroup,
+			"securityGroupsContentAudit": testAccPolicy_securityGroupsContentAudit,
 			"tags":                       testAccFMSPolicy_tagsSerial,
 			"update":                     testAccPolicy_update,
 			"rscSet":                     testAccPolicy_rscSet,
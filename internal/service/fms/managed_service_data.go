@@ -5,10 +5,14 @@ package fms
 
 import (
 	"encoding/json"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/json/ujson"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // suppressEquivalentManagedServiceDataJSON provides custom difference suppression
@@ -69,3 +73,467 @@ func removeEmptyFieldsFromJSON(in string) string {
 
 	return string(out)
 }
+
+// The typed security_service_policy_data blocks and the security service type each one is valid for.
+var managedServiceDataBlockTypes = map[string]string{
+	"dns_firewall":                  "DNS_FIREWALL",
+	"network_firewall":              "NETWORK_FIREWALL",
+	"security_groups_content_audit": "SECURITY_GROUPS_CONTENT_AUDIT",
+	"shield_advanced":               "SHIELD_ADVANCED",
+	"wafv2":                         "WAFV2",
+}
+
+// The following types mirror the ManagedServiceData JSON documented at
+// https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_SecurityServicePolicyData.html.
+
+type dnsFirewallManagedServiceData struct {
+	Type                  string                          `json:"type"`
+	PreProcessRuleGroups  []dnsFirewallRuleGroupReference `json:"preProcessRuleGroups"`
+	PostProcessRuleGroups []dnsFirewallRuleGroupReference `json:"postProcessRuleGroups"`
+}
+
+type dnsFirewallRuleGroupReference struct {
+	RuleGroupID string `json:"ruleGroupId"`
+	Priority    int    `json:"priority"`
+}
+
+type networkFirewallManagedServiceData struct {
+	Type                     string                `json:"type"`
+	AWSNetworkFirewallConfig networkFirewallConfig `json:"awsNetworkFirewallConfig"`
+}
+
+type networkFirewallConfig struct {
+	StatelessRuleGroupReferences    []networkFirewallRuleGroupReference   `json:"networkFirewallStatelessRuleGroupReferences"`
+	StatelessDefaultActions         []string                              `json:"networkFirewallStatelessDefaultActions"`
+	StatelessFragmentDefaultActions []string                              `json:"networkFirewallStatelessFragmentDefaultActions"`
+	StatefulRuleGroupReferences     []networkFirewallRuleGroupReference   `json:"networkFirewallStatefulRuleGroupReferences"`
+	StatefulEngineOptions           *networkFirewallStatefulEngineOptions `json:"networkFirewallStatefulEngineOptions,omitempty"`
+	StatefulDefaultActions          []string                              `json:"networkFirewallStatefulDefaultActions,omitempty"`
+}
+
+type networkFirewallRuleGroupReference struct {
+	ResourceARN string `json:"resourceARN"`
+	Priority    *int   `json:"priority,omitempty"`
+}
+
+type networkFirewallStatefulEngineOptions struct {
+	RuleOrder string `json:"ruleOrder"`
+}
+
+type securityGroupsContentAuditManagedServiceData struct {
+	Type                string                            `json:"type"`
+	SecurityGroupAction securityGroupsContentAuditAction  `json:"securityGroupAction"`
+	SecurityGroups      []securityGroupsContentAuditGroup `json:"securityGroups"`
+}
+
+type securityGroupsContentAuditAction struct {
+	Type string `json:"type"`
+}
+
+type securityGroupsContentAuditGroup struct {
+	ID string `json:"id"`
+}
+
+type shieldAdvancedManagedServiceData struct {
+	Type                           string                                        `json:"type"`
+	AutomaticResponseConfiguration *shieldAdvancedAutomaticResponseConfiguration `json:"automaticResponseConfiguration,omitempty"`
+	OverrideCustomerWebACLClassic  bool                                          `json:"overrideCustomerWebaclClassic"`
+}
+
+type shieldAdvancedAutomaticResponseConfiguration struct {
+	AutomaticResponseStatus string `json:"automaticResponseStatus"`
+	AutomaticResponseAction string `json:"automaticResponseAction,omitempty"`
+}
+
+type wafv2ManagedServiceData struct {
+	Type                                    string           `json:"type"`
+	PreProcessRuleGroups                    []wafv2RuleGroup `json:"preProcessRuleGroups"`
+	PostProcessRuleGroups                   []wafv2RuleGroup `json:"postProcessRuleGroups"`
+	DefaultAction                           wafv2Action      `json:"defaultAction"`
+	OverrideCustomerWebACLAssociation       bool             `json:"overrideCustomerWebACLAssociation"`
+	SampledRequestsEnabledForDefaultActions bool             `json:"sampledRequestsEnabledForDefaultActions"`
+}
+
+type wafv2Action struct {
+	Type string `json:"type"`
+}
+
+type wafv2RuleGroup struct {
+	RuleGroupARN               *string                          `json:"ruleGroupArn,omitempty"`
+	OverrideAction             wafv2Action                      `json:"overrideAction"`
+	ManagedRuleGroupIdentifier *wafv2ManagedRuleGroupIdentifier `json:"managedRuleGroupIdentifier,omitempty"`
+	RuleGroupType              string                           `json:"ruleGroupType"`
+	ExcludeRules               []wafv2ExcludeRule               `json:"excludeRules"`
+}
+
+type wafv2ManagedRuleGroupIdentifier struct {
+	Version              *string `json:"version,omitempty"`
+	VendorName           string  `json:"vendorName"`
+	ManagedRuleGroupName string  `json:"managedRuleGroupName"`
+}
+
+type wafv2ExcludeRule struct {
+	Name string `json:"name"`
+}
+
+// expandManagedServiceData returns the ManagedServiceData JSON for the configured security_service_policy_data.
+// The typed blocks take precedence over the managed_service_data argument.
+func expandManagedServiceData(tfMap map[string]any) (string, error) {
+	serviceType := tfMap[names.AttrType].(string)
+
+	for block, blockServiceType := range managedServiceDataBlockTypes {
+		v, ok := tfMap[block].([]any)
+		if !ok || len(v) == 0 {
+			continue
+		}
+
+		if serviceType != blockServiceType {
+			return "", fmt.Errorf(`"%s" requires security_service_policy_data type %q, got %q`, block, blockServiceType, serviceType)
+		}
+
+		var blockMap map[string]any
+		if v[0] != nil {
+			blockMap = v[0].(map[string]any)
+		}
+
+		var apiObject any
+		switch block {
+		case "dns_firewall":
+			apiObject = expandDNSFirewallManagedServiceData(blockMap, serviceType)
+		case "network_firewall":
+			apiObject = expandNetworkFirewallManagedServiceData(blockMap, serviceType)
+		case "security_groups_content_audit":
+			apiObject = expandSecurityGroupsContentAuditManagedServiceData(blockMap, serviceType)
+		case "shield_advanced":
+			apiObject = expandShieldAdvancedManagedServiceData(blockMap, serviceType)
+		case "wafv2":
+			apiObject = expandWAFV2ManagedServiceData(blockMap, serviceType)
+		}
+
+		output, err := json.Marshal(apiObject)
+		if err != nil {
+			return "", err
+		}
+
+		return string(output), nil
+	}
+
+	return tfMap["managed_service_data"].(string), nil
+}
+
+func expandDNSFirewallManagedServiceData(tfMap map[string]any, serviceType string) *dnsFirewallManagedServiceData {
+	apiObject := &dnsFirewallManagedServiceData{
+		Type: serviceType,
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	apiObject.PreProcessRuleGroups = expandDNSFirewallRuleGroupReferences(tfMap["pre_process_rule_group"].([]any))
+	apiObject.PostProcessRuleGroups = expandDNSFirewallRuleGroupReferences(tfMap["post_process_rule_group"].([]any))
+
+	return apiObject
+}
+
+func expandDNSFirewallRuleGroupReferences(tfList []any) []dnsFirewallRuleGroupReference {
+	apiObjects := make([]dnsFirewallRuleGroupReference, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, dnsFirewallRuleGroupReference{
+			Priority:    tfMap[names.AttrPriority].(int),
+			RuleGroupID: tfMap["rule_group_id"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandNetworkFirewallManagedServiceData(tfMap map[string]any, serviceType string) *networkFirewallManagedServiceData {
+	apiObject := &networkFirewallManagedServiceData{
+		Type: serviceType,
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	config := &apiObject.AWSNetworkFirewallConfig
+	config.StatefulDefaultActions = flex.ExpandStringValueList(tfMap["stateful_default_actions"].([]any))
+	config.StatefulRuleGroupReferences = expandNetworkFirewallRuleGroupReferences(tfMap["stateful_rule_group_reference"].([]any))
+	config.StatelessDefaultActions = flex.ExpandStringValueList(tfMap["stateless_default_actions"].([]any))
+	config.StatelessFragmentDefaultActions = flex.ExpandStringValueList(tfMap["stateless_fragment_default_actions"].([]any))
+	config.StatelessRuleGroupReferences = expandNetworkFirewallRuleGroupReferences(tfMap["stateless_rule_group_reference"].([]any))
+
+	if v, ok := tfMap["stateful_engine_options"].([]any); ok && len(v) > 0 && v[0] != nil {
+		config.StatefulEngineOptions = &networkFirewallStatefulEngineOptions{
+			RuleOrder: v[0].(map[string]any)["rule_order"].(string),
+		}
+	}
+
+	return apiObject
+}
+
+func expandNetworkFirewallRuleGroupReferences(tfList []any) []networkFirewallRuleGroupReference {
+	apiObjects := make([]networkFirewallRuleGroupReference, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := networkFirewallRuleGroupReference{
+			ResourceARN: tfMap[names.AttrResourceARN].(string),
+		}
+
+		if v, ok := tfMap[names.AttrPriority].(int); ok && v != 0 {
+			apiObject.Priority = aws.Int(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecurityGroupsContentAuditManagedServiceData(tfMap map[string]any, serviceType string) *securityGroupsContentAuditManagedServiceData {
+	apiObject := &securityGroupsContentAuditManagedServiceData{
+		Type: serviceType,
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	apiObject.SecurityGroupAction.Type = tfMap["security_group_action"].(string)
+	for _, v := range flex.ExpandStringValueSet(tfMap["security_group_ids"].(*schema.Set)) {
+		apiObject.SecurityGroups = append(apiObject.SecurityGroups, securityGroupsContentAuditGroup{ID: v})
+	}
+
+	return apiObject
+}
+
+func expandShieldAdvancedManagedServiceData(tfMap map[string]any, serviceType string) *shieldAdvancedManagedServiceData {
+	apiObject := &shieldAdvancedManagedServiceData{
+		Type: serviceType,
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	apiObject.OverrideCustomerWebACLClassic = tfMap["override_customer_web_acl_classic"].(bool)
+
+	if v, ok := tfMap["automatic_response_status"].(string); ok && v != "" {
+		apiObject.AutomaticResponseConfiguration = &shieldAdvancedAutomaticResponseConfiguration{
+			AutomaticResponseAction: tfMap["automatic_response_action"].(string),
+			AutomaticResponseStatus: v,
+		}
+	}
+
+	return apiObject
+}
+
+func expandWAFV2ManagedServiceData(tfMap map[string]any, serviceType string) *wafv2ManagedServiceData {
+	apiObject := &wafv2ManagedServiceData{
+		Type: serviceType,
+	}
+
+	if tfMap == nil {
+		return apiObject
+	}
+
+	apiObject.DefaultAction.Type = tfMap["default_action"].(string)
+	apiObject.OverrideCustomerWebACLAssociation = tfMap["override_customer_web_acl_association"].(bool)
+	apiObject.PostProcessRuleGroups = expandWAFV2RuleGroups(tfMap["post_process_rule_group"].([]any))
+	apiObject.PreProcessRuleGroups = expandWAFV2RuleGroups(tfMap["pre_process_rule_group"].([]any))
+	apiObject.SampledRequestsEnabledForDefaultActions = tfMap["sampled_requests_enabled_for_default_actions"].(bool)
+
+	return apiObject
+}
+
+func expandWAFV2RuleGroups(tfList []any) []wafv2RuleGroup {
+	apiObjects := make([]wafv2RuleGroup, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := wafv2RuleGroup{
+			ExcludeRules:   []wafv2ExcludeRule{},
+			OverrideAction: wafv2Action{Type: tfMap["override_action"].(string)},
+			RuleGroupType:  tfMap["rule_group_type"].(string),
+		}
+
+		for _, v := range flex.ExpandStringValueList(tfMap["exclude_rules"].([]any)) {
+			apiObject.ExcludeRules = append(apiObject.ExcludeRules, wafv2ExcludeRule{Name: v})
+		}
+
+		if v, ok := tfMap["managed_rule_group_identifier"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+			apiObject.ManagedRuleGroupIdentifier = &wafv2ManagedRuleGroupIdentifier{
+				ManagedRuleGroupName: tfMap["managed_rule_group_name"].(string),
+				VendorName:           tfMap["vendor_name"].(string),
+			}
+
+			if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+				apiObject.ManagedRuleGroupIdentifier.Version = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["rule_group_arn"].(string); ok && v != "" {
+			apiObject.RuleGroupARN = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenManagedServiceData decodes the ManagedServiceData JSON into the given typed block.
+func flattenManagedServiceData(block, managedServiceData string) ([]any, error) {
+	var (
+		tfMap map[string]any
+		err   error
+	)
+
+	switch block {
+	case "dns_firewall":
+		var apiObject dnsFirewallManagedServiceData
+		if err = json.Unmarshal([]byte(managedServiceData), &apiObject); err == nil {
+			tfMap = map[string]any{
+				"post_process_rule_group": flattenDNSFirewallRuleGroupReferences(apiObject.PostProcessRuleGroups),
+				"pre_process_rule_group":  flattenDNSFirewallRuleGroupReferences(apiObject.PreProcessRuleGroups),
+			}
+		}
+	case "network_firewall":
+		var apiObject networkFirewallManagedServiceData
+		if err = json.Unmarshal([]byte(managedServiceData), &apiObject); err == nil {
+			config := apiObject.AWSNetworkFirewallConfig
+			tfMap = map[string]any{
+				"stateful_default_actions":           config.StatefulDefaultActions,
+				"stateful_rule_group_reference":      flattenNetworkFirewallRuleGroupReferences(config.StatefulRuleGroupReferences),
+				"stateless_default_actions":          config.StatelessDefaultActions,
+				"stateless_fragment_default_actions": config.StatelessFragmentDefaultActions,
+				"stateless_rule_group_reference":     flattenNetworkFirewallRuleGroupReferences(config.StatelessRuleGroupReferences),
+			}
+
+			if v := config.StatefulEngineOptions; v != nil {
+				tfMap["stateful_engine_options"] = []any{map[string]any{
+					"rule_order": v.RuleOrder,
+				}}
+			}
+		}
+	case "security_groups_content_audit":
+		var apiObject securityGroupsContentAuditManagedServiceData
+		if err = json.Unmarshal([]byte(managedServiceData), &apiObject); err == nil {
+			securityGroupIDs := make([]string, 0, len(apiObject.SecurityGroups))
+			for _, v := range apiObject.SecurityGroups {
+				securityGroupIDs = append(securityGroupIDs, v.ID)
+			}
+
+			tfMap = map[string]any{
+				"security_group_action": apiObject.SecurityGroupAction.Type,
+				"security_group_ids":    securityGroupIDs,
+			}
+		}
+	case "shield_advanced":
+		var apiObject shieldAdvancedManagedServiceData
+		if err = json.Unmarshal([]byte(managedServiceData), &apiObject); err == nil {
+			tfMap = map[string]any{
+				"override_customer_web_acl_classic": apiObject.OverrideCustomerWebACLClassic,
+			}
+
+			if v := apiObject.AutomaticResponseConfiguration; v != nil {
+				tfMap["automatic_response_action"] = v.AutomaticResponseAction
+				tfMap["automatic_response_status"] = v.AutomaticResponseStatus
+			}
+		}
+	case "wafv2":
+		var apiObject wafv2ManagedServiceData
+		if err = json.Unmarshal([]byte(managedServiceData), &apiObject); err == nil {
+			tfMap = map[string]any{
+				"default_action":                               apiObject.DefaultAction.Type,
+				"override_customer_web_acl_association":        apiObject.OverrideCustomerWebACLAssociation,
+				"post_process_rule_group":                      flattenWAFV2RuleGroups(apiObject.PostProcessRuleGroups),
+				"pre_process_rule_group":                       flattenWAFV2RuleGroups(apiObject.PreProcessRuleGroups),
+				"sampled_requests_enabled_for_default_actions": apiObject.SampledRequestsEnabledForDefaultActions,
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s managed service data: %w", block, err)
+	}
+
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	return []any{tfMap}, nil
+}
+
+func flattenDNSFirewallRuleGroupReferences(apiObjects []dnsFirewallRuleGroupReference) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrPriority: apiObject.Priority,
+			"rule_group_id":    apiObject.RuleGroupID,
+		})
+	}
+
+	return tfList
+}
+
+func flattenNetworkFirewallRuleGroupReferences(apiObjects []networkFirewallRuleGroupReference) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrPriority:    aws.ToInt(apiObject.Priority),
+			names.AttrResourceARN: apiObject.ResourceARN,
+		})
+	}
+
+	return tfList
+}
+
+func flattenWAFV2RuleGroups(apiObjects []wafv2RuleGroup) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		excludeRules := make([]string, 0, len(apiObject.ExcludeRules))
+		for _, v := range apiObject.ExcludeRules {
+			excludeRules = append(excludeRules, v.Name)
+		}
+
+		tfMap := map[string]any{
+			"exclude_rules":   excludeRules,
+			"override_action": apiObject.OverrideAction.Type,
+			"rule_group_arn":  aws.ToString(apiObject.RuleGroupARN),
+			"rule_group_type": apiObject.RuleGroupType,
+		}
+
+		if v := apiObject.ManagedRuleGroupIdentifier; v != nil {
+			tfMap["managed_rule_group_identifier"] = []any{map[string]any{
+				"managed_rule_group_name": v.ManagedRuleGroupName,
+				names.AttrVersion:         aws.ToString(v.Version),
+				"vendor_name":             v.VendorName,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -6,6 +6,7 @@ package fms_test
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	tffms "github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 )
 
@@ -69,3 +70,80 @@ func TestRemoveEmptyFieldsFromJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenManagedServiceData(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		block    string
+		input    string
+		want     []any
+	}{
+		{
+			testName: "DNS_FIREWALL",
+			block:    "dns_firewall",
+			input:    `{"type":"DNS_FIREWALL","preProcessRuleGroups":[{"ruleGroupId":"rslvr-frg-1","priority":10}],"postProcessRuleGroups":[{"ruleGroupId":"rslvr-frg-2","priority":9911}]}`,
+			want: []any{map[string]any{
+				"post_process_rule_group": []any{map[string]any{"priority": 9911, "rule_group_id": "rslvr-frg-2"}},
+				"pre_process_rule_group":  []any{map[string]any{"priority": 10, "rule_group_id": "rslvr-frg-1"}},
+			}},
+		},
+		{
+			testName: "SECURITY_GROUPS_CONTENT_AUDIT",
+			block:    "security_groups_content_audit",
+			input:    `{"type":"SECURITY_GROUPS_CONTENT_AUDIT","securityGroupAction":{"type":"DENY"},"securityGroups":[{"id":"sg-12345678"}]}`,
+			want: []any{map[string]any{
+				"security_group_action": "DENY",
+				"security_group_ids":    []string{"sg-12345678"},
+			}},
+		},
+		{
+			testName: "SHIELD_ADVANCED",
+			block:    "shield_advanced",
+			input:    `{"type":"SHIELD_ADVANCED","automaticResponseConfiguration":{"automaticResponseStatus":"ENABLED","automaticResponseAction":"COUNT"},"overrideCustomerWebaclClassic":true}`,
+			want: []any{map[string]any{
+				"automatic_response_action":         "COUNT",
+				"automatic_response_status":         "ENABLED",
+				"override_customer_web_acl_classic": true,
+			}},
+		},
+		{
+			testName: "WAFV2",
+			block:    "wafv2",
+			input:    `{"type":"WAFV2","preProcessRuleGroups":[{"ruleGroupArn":null,"overrideAction":{"type":"NONE"},"managedRuleGroupIdentifier":{"version":null,"vendorName":"AWS","managedRuleGroupName":"AWSManagedRulesAmazonIpReputationList"},"ruleGroupType":"ManagedRuleGroup","excludeRules":[{"name":"AWSManagedIPReputationList"}]}],"postProcessRuleGroups":[],"defaultAction":{"type":"ALLOW"},"overrideCustomerWebACLAssociation":false,"sampledRequestsEnabledForDefaultActions":true}`,
+			want: []any{map[string]any{
+				"default_action":                        "ALLOW",
+				"override_customer_web_acl_association": false,
+				"post_process_rule_group":               []any{},
+				"pre_process_rule_group": []any{map[string]any{
+					"exclude_rules": []string{"AWSManagedIPReputationList"},
+					"managed_rule_group_identifier": []any{map[string]any{
+						"managed_rule_group_name": "AWSManagedRulesAmazonIpReputationList",
+						"vendor_name":             "AWS",
+						"version":                 "",
+					}},
+					"override_action": "NONE",
+					"rule_group_arn":  "",
+					"rule_group_type": "ManagedRuleGroup",
+				}},
+				"sampled_requests_enabled_for_default_actions": true,
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffms.FlattenManagedServiceData(testCase.block, testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
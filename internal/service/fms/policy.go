@@ -44,6 +44,104 @@ func resourcePolicy() *schema.Resource {
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
+			managedServiceDataConflictsWith := func(attr string) []string {
+				var conflictsWith []string
+				for _, v := range []string{"dns_firewall", "managed_service_data", "network_firewall", "security_groups_content_audit", "shield_advanced", "wafv2"} {
+					if v != attr {
+						conflictsWith = append(conflictsWith, "security_service_policy_data.0."+v)
+					}
+				}
+				return conflictsWith
+			}
+			dnsFirewallRuleGroupNestedBlock := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrPriority: {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+							"rule_group_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				}
+			}
+			networkFirewallRuleGroupNestedBlock := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrPriority: {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							names.AttrResourceARN: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				}
+			}
+			wafv2RuleGroupNestedBlock := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"exclude_rules": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"managed_rule_group_identifier": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"managed_rule_group_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"vendor_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrVersion: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"override_action": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "NONE",
+								ValidateFunc: validation.StringInSlice([]string{"COUNT", "NONE"}, false),
+							},
+							"rule_group_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"rule_group_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"ManagedRuleGroup", "RuleGroup"}, false),
+							},
+						},
+					},
+				}
+			}
 			networkACLEntrySetNestedBlock := func() *schema.Schema {
 				return &schema.Schema{
 					Type:     schema.TypeSet,
@@ -229,6 +327,18 @@ func resourcePolicy() *schema.Resource {
 					MaxItems: 1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"dns_firewall": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: managedServiceDataConflictsWith("dns_firewall"),
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"post_process_rule_group": dnsFirewallRuleGroupNestedBlock(),
+										"pre_process_rule_group":  dnsFirewallRuleGroupNestedBlock(),
+									},
+								},
+							},
 							"managed_service_data": {
 								Type:                  schema.TypeString,
 								Optional:              true,
@@ -239,6 +349,48 @@ func resourcePolicy() *schema.Resource {
 									json, _ := structure.NormalizeJsonString(v)
 									return json
 								},
+								ConflictsWith: managedServiceDataConflictsWith("managed_service_data"),
+							},
+							"network_firewall": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: managedServiceDataConflictsWith("network_firewall"),
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"stateful_default_actions": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"stateful_engine_options": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"rule_order": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringInSlice([]string{"DEFAULT_ACTION_ORDER", "STRICT_ORDER"}, false),
+													},
+												},
+											},
+										},
+										"stateful_rule_group_reference": networkFirewallRuleGroupNestedBlock(),
+										"stateless_default_actions": {
+											Type:     schema.TypeList,
+											Required: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"stateless_fragment_default_actions": {
+											Type:     schema.TypeList,
+											Required: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"stateless_rule_group_reference": networkFirewallRuleGroupNestedBlock(),
+									},
+								},
 							},
 							"policy_option": {
 								Type:     schema.TypeList,
@@ -305,10 +457,80 @@ func resourcePolicy() *schema.Resource {
 									},
 								},
 							},
+							"security_groups_content_audit": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: managedServiceDataConflictsWith("security_groups_content_audit"),
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"security_group_action": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice([]string{"ALLOW", "DENY"}, false),
+										},
+										"security_group_ids": {
+											Type:     schema.TypeSet,
+											Required: true,
+											MinItems: 1,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"shield_advanced": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: managedServiceDataConflictsWith("shield_advanced"),
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"automatic_response_action": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"BLOCK", "COUNT"}, false),
+										},
+										"automatic_response_status": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"DISABLED", "ENABLED", "IGNORED"}, false),
+										},
+										"override_customer_web_acl_classic": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+									},
+								},
+							},
 							names.AttrType: {
 								Type:     schema.TypeString,
 								Required: true,
 							},
+							"wafv2": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: managedServiceDataConflictsWith("wafv2"),
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"default_action": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice([]string{"ALLOW", "BLOCK"}, false),
+										},
+										"override_customer_web_acl_association": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"post_process_rule_group": wafv2RuleGroupNestedBlock(),
+										"pre_process_rule_group":  wafv2RuleGroupNestedBlock(),
+										"sampled_requests_enabled_for_default_actions": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -323,8 +545,13 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FMSClient(ctx)
 
+	policy, err := expandPolicy(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input := &fms.PutPolicyInput{
-		Policy:  expandPolicy(d),
+		Policy:  policy,
 		TagList: getTagsIn(ctx),
 	}
 
@@ -386,10 +613,23 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set("resource_type_list", policy.ResourceTypeList)
 	d.Set("resource_set_ids", policy.ResourceSetIds)
 	securityServicePolicy := []map[string]any{{
-		names.AttrType:         string(policy.SecurityServicePolicyData.Type),
-		"managed_service_data": aws.ToString(policy.SecurityServicePolicyData.ManagedServiceData),
-		"policy_option":        flattenPolicyOption(policy.SecurityServicePolicyData.PolicyOption),
+		names.AttrType:  string(policy.SecurityServicePolicyData.Type),
+		"policy_option": flattenPolicyOption(policy.SecurityServicePolicyData.PolicyOption),
 	}}
+	// Only populate the typed block that is already in use, falling back to the raw JSON (e.g. on import).
+	managedServiceData := aws.ToString(policy.SecurityServicePolicyData.ManagedServiceData)
+	securityServicePolicy[0]["managed_service_data"] = managedServiceData
+	for block := range managedServiceDataBlockTypes {
+		if v, ok := d.GetOk("security_service_policy_data.0." + block); ok && len(v.([]any)) > 0 {
+			tfList, err := flattenManagedServiceData(block, managedServiceData)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading FMS Policy (%s): %s", d.Id(), err)
+			}
+
+			securityServicePolicy[0][block] = tfList
+			securityServicePolicy[0]["managed_service_data"] = ""
+		}
+	}
 	if err := d.Set("security_service_policy_data", securityServicePolicy); err != nil {
 		diags = sdkdiag.AppendErrorf(diags, "setting security_service_policy_data: %s", err)
 	}
@@ -402,8 +642,13 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	conn := meta.(*conns.AWSClient).FMSClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		policy, err := expandPolicy(d)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input := &fms.PutPolicyInput{
-			Policy: expandPolicy(d),
+			Policy: policy,
 		}
 
 		// System problems can arise during FMS policy updates (maybe also creation),
@@ -412,7 +657,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		const (
 			timeout = 1 * time.Minute
 		)
-		_, err := tfresource.RetryWhenIsA[any, *awstypes.InternalErrorException](ctx, timeout, func(ctx context.Context) (any, error) {
+		_, err = tfresource.RetryWhenIsA[any, *awstypes.InternalErrorException](ctx, timeout, func(ctx context.Context) (any, error) {
 			return conn.PutPolicy(ctx, input)
 		})
 
@@ -470,7 +715,7 @@ func findPolicyByID(ctx context.Context, conn *fms.Client, id string) (*fms.GetP
 	return output, nil
 }
 
-func expandPolicy(d *schema.ResourceData) *awstypes.Policy {
+func expandPolicy(d *schema.ResourceData) (*awstypes.Policy, error) {
 	resourceType := aws.String("ResourceTypeList")
 	if v, ok := d.GetOk(names.AttrResourceType); ok {
 		resourceType = aws.String(v.(string))
@@ -505,8 +750,13 @@ func expandPolicy(d *schema.ResourceData) *awstypes.Policy {
 	}
 
 	tfMap := d.Get("security_service_policy_data").([]any)[0].(map[string]any)
+	managedServiceData, err := expandManagedServiceData(tfMap)
+	if err != nil {
+		return nil, err
+	}
+
 	apiObject.SecurityServicePolicyData = &awstypes.SecurityServicePolicyData{
-		ManagedServiceData: aws.String(tfMap["managed_service_data"].(string)),
+		ManagedServiceData: aws.String(managedServiceData),
 		Type:               awstypes.SecurityServiceType(tfMap[names.AttrType].(string)),
 	}
 
@@ -514,7 +764,7 @@ func expandPolicy(d *schema.ResourceData) *awstypes.Policy {
 		apiObject.SecurityServicePolicyData.PolicyOption = expandPolicyOption(v[0].(map[string]any))
 	}
 
-	return apiObject
+	return apiObject, nil
 }
 
 func expandPolicyOption(tfMap map[string]any) *awstypes.PolicyOption {
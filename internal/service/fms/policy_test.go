@@ -372,6 +372,45 @@ func testAccPolicy_securityGroup(t *testing.T) {
 	})
 }
 
+func testAccPolicy_securityGroupsContentAudit(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_securityGroupsContentAudit(rName, "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.managed_service_data", ""),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_content_audit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_content_audit.0.security_group_action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_content_audit.0.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_service_policy_data.0.security_groups_content_audit.0.security_group_ids.*", "aws_security_group.test", names.AttrID),
+				),
+			},
+			{
+				Config: testAccPolicyConfig_securityGroupsContentAudit(rName, "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_content_audit.0.security_group_action", "DENY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPolicy_rscSet(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -928,6 +967,53 @@ resource "aws_fms_policy" "test" {
 `, rName))
 }
 
+func testAccPolicyConfig_securityGroupsContentAudit(rName, action string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_fms_policy" "test" {
+  name                        = %[1]q
+  delete_all_policy_resources = false
+  exclude_resource_tags       = false
+  remediation_enabled         = false
+  resource_type               = "AWS::EC2::SecurityGroup"
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_CONTENT_AUDIT"
+
+    security_groups_content_audit {
+      security_group_action = %[2]q
+      security_group_ids    = [aws_security_group.test.id]
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName, action))
+}
+
 func testAccPolicyConfig_rscSet(policyName string) string {
 	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
 resource "aws_fms_policy" "test" {
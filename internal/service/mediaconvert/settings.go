@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// Preset and job template settings are deeply nested structures that are managed as JSON documents.
+// The documents use the same (PascalCase) field names as the AWS CLI and the MediaConvert console's "Export JSON".
+
+// expandSettings decodes a JSON settings document into the specified API object.
+func expandSettings[T any](s string) (*T, error) {
+	var apiObject T
+
+	if err := tfjson.DecodeFromString(s, &apiObject); err != nil {
+		return nil, err
+	}
+
+	return &apiObject, nil
+}
+
+// flattenSettings encodes an API object as a JSON settings document, omitting empty fields.
+func flattenSettings(apiObject any) (string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	var v any
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+
+	// Unset enumerations are encoded as empty strings.
+	v, _ = pruneEmptyJSONValues(v)
+
+	if v == nil {
+		return "", nil
+	}
+
+	b, err = json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// pruneEmptyJSONValues removes null values, empty strings, and empty arrays and objects from a decoded JSON value.
+// Returns false if the value itself is empty.
+func pruneEmptyJSONValues(v any) (any, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case map[string]any:
+		for k, e := range v {
+			if e, ok := pruneEmptyJSONValues(e); ok {
+				v[k] = e
+			} else {
+				delete(v, k)
+			}
+		}
+
+		return v, len(v) > 0
+	case []any:
+		var s []any
+
+		for _, e := range v {
+			if e, ok := pruneEmptyJSONValues(e); ok {
+				s = append(s, e)
+			}
+		}
+
+		return s, len(s) > 0
+	default:
+		return v, true
+	}
+}
+
+// normalizeSettings decodes a JSON settings document into the specified API object and encodes it again,
+// dropping unknown fields and empty values and ordering the keys consistently.
+func normalizeSettings[T any](s string) (string, error) {
+	apiObject, err := expandSettings[T](s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return flattenSettings(apiObject)
+}
+
+// suppressEquivalentSettings returns a DiffSuppressFunc for a JSON settings document.
+// The documents are equivalent if they are equal once normalized.
+func suppressEquivalentSettings[T any]() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || new == "" {
+			return old == new
+		}
+
+		old, err := normalizeSettings[T](old)
+
+		if err != nil {
+			return false
+		}
+
+		new, err = normalizeSettings[T](new)
+
+		if err != nil {
+			return false
+		}
+
+		return old == new
+	}
+}
+
+// settingsToSet returns the settings document to store in state.
+// MediaConvert fills in default values for unspecified settings, so the configured document
+// is kept if all of its values are present in the document returned by the API.
+func settingsToSet[T any](configured, apiDocument string) (string, error) {
+	if configured == "" || apiDocument == "" {
+		return apiDocument, nil
+	}
+
+	n, err := normalizeSettings[T](configured)
+
+	if err != nil {
+		return "", err
+	}
+
+	var got, want any
+
+	if err := json.Unmarshal([]byte(apiDocument), &got); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(n), &want); err != nil {
+		return "", err
+	}
+
+	if jsonValueContains(got, want) {
+		return configured, nil
+	}
+
+	return apiDocument, nil
+}
+
+// jsonValueContains returns whether every value in the decoded JSON value want is also present in got.
+func jsonValueContains(got, want any) bool {
+	switch want := want.(type) {
+	case map[string]any:
+		got, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for k, v := range want {
+			if !jsonValueContains(got[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		got, ok := got.([]any)
+		if !ok || len(got) != len(want) {
+			return false
+		}
+
+		for i, v := range want {
+			if !jsonValueContains(got[i], v) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return got == want
+	}
+}
+
+// suppressEquivalentQueue suppresses differences between a queue's name and its ARN.
+func suppressEquivalentQueue(k, old, new string, d *schema.ResourceData) bool {
+	return queueName(old) == queueName(new)
+}
+
+func queueName(v string) string {
+	if queueARN, err := arn.Parse(v); err == nil {
+		return strings.TrimPrefix(queueARN.Resource, "queues/")
+	}
+
+	return v
+}
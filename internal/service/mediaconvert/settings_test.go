@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+)
+
+func TestSuppressEquivalentSettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new string
+		want     bool
+	}{
+		"empty": {
+			want: true,
+		},
+		"new": {
+			new:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			want: false,
+		},
+		"identical": {
+			old:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			new:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			want: true,
+		},
+		"reformatted": {
+			old:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			new:  `{ "ContainerSettings": { "Container": "MP4" } }`,
+			want: true,
+		},
+		"empty values": {
+			old:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			new:  `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":""}}}`,
+			want: true,
+		},
+		"changed": {
+			old:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			new:  `{"ContainerSettings":{"Container":"MOV"}}`,
+			want: false,
+		},
+		"added": {
+			old:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			new:  `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"EXCLUDE"}}}`,
+			want: false,
+		},
+		"removed": {
+			old:  `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"EXCLUDE"}}}`,
+			new:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			want: false,
+		},
+	}
+
+	f := suppressEquivalentSettings[types.PresetSettings]()
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := f("settings", testCase.old, testCase.new, nil), testCase.want; got != want {
+				t.Errorf("suppressEquivalentSettings(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
+}
+
+func TestSettingsToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configured, apiDocument string
+		want                    string
+	}{
+		"import": {
+			apiDocument: `{"ContainerSettings":{"Container":"MP4"}}`,
+			want:        `{"ContainerSettings":{"Container":"MP4"}}`,
+		},
+		"defaults filled in": {
+			configured:  `{ "ContainerSettings": { "Container": "MP4" } }`,
+			apiDocument: `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"INCLUDE","FreeSpaceBox":"EXCLUDE"}}}`,
+			want:        `{ "ContainerSettings": { "Container": "MP4" } }`,
+		},
+		"changed outside Terraform": {
+			configured:  `{"ContainerSettings":{"Container":"MP4"}}`,
+			apiDocument: `{"ContainerSettings":{"Container":"MOV"}}`,
+			want:        `{"ContainerSettings":{"Container":"MOV"}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := settingsToSet[types.PresetSettings](testCase.configured, testCase.apiDocument)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("settingsToSet(%q, %q) = %q, want %q", testCase.configured, testCase.apiDocument, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestQueueName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Default": "Default",
+		"arn:aws:mediaconvert:us-west-2:123456789012:queues/Default": "Default", //lintignore:AWSAT003,AWSAT005
+	}
+
+	for input, want := range testCases {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := queueName(input); got != want {
+				t.Errorf("queueName(%q) = %q, want %q", input, got, want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -196,7 +197,6 @@ func resourceBroker() *schema.Resource {
 			"ldap_server_metadata": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -384,6 +384,24 @@ func resourceBroker() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
+				engineType := diff.Get("engine_type").(string)
+
+				switch deploymentMode := diff.Get("deployment_mode").(string); {
+				case strings.EqualFold(deploymentMode, string(types.DeploymentModeClusterMultiAz)) && !strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)):
+					return fmt.Errorf("deployment_mode: %s is only supported when engine is RabbitMQ", types.DeploymentModeClusterMultiAz)
+				case strings.EqualFold(deploymentMode, string(types.DeploymentModeActiveStandbyMultiAz)) && !strings.EqualFold(engineType, string(types.EngineTypeActivemq)):
+					return fmt.Errorf("deployment_mode: %s is only supported when engine is ActiveMQ", types.DeploymentModeActiveStandbyMultiAz)
+				}
+
+				if strings.EqualFold(diff.Get("authentication_strategy").(string), string(types.AuthenticationStrategyLdap)) {
+					if v, ok := diff.GetOk("ldap_server_metadata"); !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+						return fmt.Errorf("ldap_server_metadata: must be configured when authentication_strategy is %s", types.AuthenticationStrategyLdap)
+					}
+				}
+
 				return nil
 			},
 		),
@@ -634,6 +652,25 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	if d.HasChanges("authentication_strategy", "ldap_server_metadata") {
+		input := &mq.UpdateBrokerInput{
+			BrokerId:           aws.String(d.Id()),
+			LdapServerMetadata: expandLDAPServerMetadata(d.Get("ldap_server_metadata").([]any)),
+		}
+
+		if v, ok := d.GetOk("authentication_strategy"); ok {
+			input.AuthenticationStrategy = types.AuthenticationStrategy(v.(string))
+		}
+
+		_, err := conn.UpdateBroker(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) authentication: %s", d.Id(), err)
+		}
+
+		requiresReboot = true
+	}
+
 	if d.HasChange("data_replication_mode") {
 		input := &mq.UpdateBrokerInput{
 			BrokerId:            aws.String(d.Id()),
@@ -650,16 +687,26 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if d.Get(names.AttrApplyImmediately).(bool) && requiresReboot {
-		_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
-			BrokerId: aws.String(d.Id()),
-		})
+		// Not every update leaves changes pending, e.g. a configuration revision that is already current.
+		// Only reboot, and so interrupt client connections, when the broker reports changes awaiting a reboot.
+		output, err := findBrokerByID(ctx, conn, d.Id())
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "rebooting MQ Broker (%s): %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "reading MQ Broker (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitBrokerRebooted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
+		if brokerHasPendingChanges(output) {
+			_, err := conn.RebootBroker(ctx, &mq.RebootBrokerInput{
+				BrokerId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "rebooting MQ Broker (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitBrokerRebooted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -785,6 +832,32 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+// brokerHasPendingChanges returns whether the broker has changes that are only applied on reboot.
+func brokerHasPendingChanges(output *mq.DescribeBrokerOutput) bool {
+	if output.PendingAuthenticationStrategy != "" ||
+		output.PendingDataReplicationMode != "" ||
+		aws.ToString(output.PendingEngineVersion) != "" ||
+		aws.ToString(output.PendingHostInstanceType) != "" ||
+		output.PendingLdapServerMetadata != nil ||
+		len(output.PendingSecurityGroups) > 0 {
+		return true
+	}
+
+	if v := output.Configurations; v != nil && v.Pending != nil {
+		if v.Current == nil || aws.ToString(v.Pending.Id) != aws.ToString(v.Current.Id) || aws.ToInt32(v.Pending.Revision) != aws.ToInt32(v.Current.Revision) {
+			return true
+		}
+	}
+
+	if v := output.Logs; v != nil && v.Pending != nil {
+		return true
+	}
+
+	return slices.ContainsFunc(output.Users, func(v types.UserSummary) bool {
+		return v.PendingChange != ""
+	})
+}
+
 func resourceUserHash(v any) int {
 	var buf bytes.Buffer
 
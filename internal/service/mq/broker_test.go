@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -327,6 +328,80 @@ const (
 	testAccRabbitVersionNormalized3_13 = "3.13"
 )
 
+func TestBrokerHasPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		output *mq.DescribeBrokerOutput
+		want   bool
+	}{
+		{
+			name:   "empty",
+			output: &mq.DescribeBrokerOutput{},
+			want:   false,
+		},
+		{
+			name: "pending engine version",
+			output: &mq.DescribeBrokerOutput{
+				PendingEngineVersion: aws.String("5.18"),
+			},
+			want: true,
+		},
+		{
+			name: "pending host instance type",
+			output: &mq.DescribeBrokerOutput{
+				PendingHostInstanceType: aws.String("mq.m5.large"),
+			},
+			want: true,
+		},
+		{
+			name: "current configuration",
+			output: &mq.DescribeBrokerOutput{
+				Configurations: &types.Configurations{
+					Current: &types.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int32(2)},
+					Pending: &types.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int32(2)},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "pending configuration revision",
+			output: &mq.DescribeBrokerOutput{
+				Configurations: &types.Configurations{
+					Current: &types.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int32(2)},
+					Pending: &types.ConfigurationId{Id: aws.String("c-1"), Revision: aws.Int32(3)},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "users without pending changes",
+			output: &mq.DescribeBrokerOutput{
+				Users: []types.UserSummary{{Username: aws.String("Test")}},
+			},
+			want: false,
+		},
+		{
+			name: "user pending update",
+			output: &mq.DescribeBrokerOutput{
+				Users: []types.UserSummary{{PendingChange: types.ChangeTypeUpdate, Username: aws.String("Test")}},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfmq.BrokerHasPendingChanges(tt.output); got != tt.want {
+				t.Errorf("BrokerHasPendingChanges() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAccMQBroker_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1581,6 +1656,44 @@ func TestAccMQBroker_ldap(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.user_search_subtree", acctest.CtTrue),
 				),
 			},
+			{
+				Config: testAccBrokerConfig_ldap(rName, testAccBrokerVersionNewer, "otherusername"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_username", "otherusername"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMQBroker_Validation_deploymentMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrokerConfig_deploymentMode(rName, "ActiveMQ", testAccBrokerVersionNewer, "CLUSTER_MULTI_AZ"),
+				ExpectError: regexache.MustCompile(`deployment_mode: CLUSTER_MULTI_AZ is only supported when engine is RabbitMQ`),
+			},
+			{
+				Config:      testAccBrokerConfig_deploymentMode(rName, "RabbitMQ", testAccRabbitVersion, "ACTIVE_STANDBY_MULTI_AZ"),
+				ExpectError: regexache.MustCompile(`deployment_mode: ACTIVE_STANDBY_MULTI_AZ is only supported when engine is ActiveMQ`),
+			},
 		},
 	})
 }
@@ -2607,6 +2720,23 @@ resource "aws_mq_broker" "test" {
 `, rName, version, ldapUsername)
 }
 
+func testAccBrokerConfig_deploymentMode(rName, engineType, version, deploymentMode string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  deployment_mode    = %[4]q
+  engine_type        = %[2]q
+  engine_version     = %[3]q
+  host_instance_type = "mq.m5.large"
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, engineType, version, deploymentMode)
+}
+
 func testAccBrokerConfig_instanceType(rName, version, instanceType string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -16,7 +16,17 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_qbusiness_application", sweepApplications)
+	awsv2.Register("aws_qbusiness_application", sweepApplications,
+		"aws_qbusiness_index",
+		"aws_qbusiness_plugin",
+		"aws_qbusiness_retriever",
+		"aws_qbusiness_web_experience",
+	)
+	awsv2.Register("aws_qbusiness_data_source", sweepDataSources)
+	awsv2.Register("aws_qbusiness_index", sweepIndices, "aws_qbusiness_data_source", "aws_qbusiness_retriever")
+	awsv2.Register("aws_qbusiness_plugin", sweepPlugins)
+	awsv2.Register("aws_qbusiness_retriever", sweepRetrievers)
+	awsv2.Register("aws_qbusiness_web_experience", sweepWebExperiences)
 }
 
 func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -41,3 +51,221 @@ func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 
 	return sweepResources, nil
 }
+
+func sweepDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	conn := client.QBusinessClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	paginator := qbusiness.NewListApplicationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			applicationID := aws.ToString(v.ApplicationId)
+			input := &qbusiness.ListIndicesInput{
+				ApplicationId: aws.String(applicationID),
+			}
+
+			paginator := qbusiness.NewListIndicesPaginator(conn, input)
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Indices {
+					indexID := aws.ToString(v.IndexId)
+					input := &qbusiness.ListDataSourcesInput{
+						ApplicationId: aws.String(applicationID),
+						IndexId:       aws.String(indexID),
+					}
+
+					paginator := qbusiness.NewListDataSourcesPaginator(conn, input)
+					for paginator.HasMorePages() {
+						page, err := paginator.NextPage(ctx)
+
+						if err != nil {
+							return nil, err
+						}
+
+						for _, v := range page.DataSources {
+							sweepResources = append(sweepResources, framework.NewSweepResource(newDataSourceResource, client,
+								framework.NewAttribute(names.AttrApplicationID, applicationID),
+								framework.NewAttribute("data_source_id", aws.ToString(v.DataSourceId)),
+								framework.NewAttribute("index_id", indexID)),
+							)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepIndices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	conn := client.QBusinessClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	paginator := qbusiness.NewListApplicationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			applicationID := aws.ToString(v.ApplicationId)
+			input := &qbusiness.ListIndicesInput{
+				ApplicationId: aws.String(applicationID),
+			}
+
+			paginator := qbusiness.NewListIndicesPaginator(conn, input)
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Indices {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newIndexResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("index_id", aws.ToString(v.IndexId))),
+					)
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPlugins(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	conn := client.QBusinessClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	paginator := qbusiness.NewListApplicationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			applicationID := aws.ToString(v.ApplicationId)
+			input := &qbusiness.ListPluginsInput{
+				ApplicationId: aws.String(applicationID),
+			}
+
+			paginator := qbusiness.NewListPluginsPaginator(conn, input)
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Plugins {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPluginResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("plugin_id", aws.ToString(v.PluginId))),
+					)
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRetrievers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	conn := client.QBusinessClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	paginator := qbusiness.NewListApplicationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			applicationID := aws.ToString(v.ApplicationId)
+			input := &qbusiness.ListRetrieversInput{
+				ApplicationId: aws.String(applicationID),
+			}
+
+			paginator := qbusiness.NewListRetrieversPaginator(conn, input)
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Retrievers {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newRetrieverResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("retriever_id", aws.ToString(v.RetrieverId))),
+					)
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepWebExperiences(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	conn := client.QBusinessClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	paginator := qbusiness.NewListApplicationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			applicationID := aws.ToString(v.ApplicationId)
+			input := &qbusiness.ListWebExperiencesInput{
+				ApplicationId: aws.String(applicationID),
+			}
+
+			paginator := qbusiness.NewListWebExperiencesPaginator(conn, input)
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.WebExperiences {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newWebExperienceResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("web_experience_id", aws.ToString(v.WebExperienceId))),
+					)
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
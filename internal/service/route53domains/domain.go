@@ -347,21 +347,29 @@ func (r *domainResource) Create(ctx context.Context, request resource.CreateRequ
 		}
 	}
 
-	if transferLock := fwflex.BoolValueFromFramework(ctx, data.TransferLock); transferLock {
+	domainDetail, err := findDomainDetailByName(ctx, conn, domainName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s)", domainName), err.Error())
+
+		return
+	}
+
+	// Whether a newly registered domain is locked against transfer depends on the TLD, so reconcile with the configured value.
+	if transferLock := fwflex.BoolValueFromFramework(ctx, data.TransferLock); transferLock != hasDomainTransferLock(domainDetail.StatusList) {
 		if err := modifyDomainTransferLock(ctx, conn, domainName, transferLock, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
 			response.Diagnostics.AddError("post-registration", err.Error())
 
 			return
 		}
-	}
 
-	// Set values for unknowns.
-	domainDetail, err := findDomainDetailByName(ctx, conn, domainName)
+		domainDetail, err = findDomainDetailByName(ctx, conn, domainName)
 
-	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s)", domainName), err.Error())
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Domains Domain (%s)", domainName), err.Error())
 
-		return
+			return
+		}
 	}
 
 	fixupDomainDetail(domainDetail)
@@ -95,9 +95,12 @@ func resourceConfigRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	var autodefinedReverseFlag awstypes.AutodefinedReverseFlag
-	if resolverConfig.AutodefinedReverse == awstypes.ResolverAutodefinedReverseStatusEnabled {
+	switch resolverConfig.AutodefinedReverse {
+	case awstypes.ResolverAutodefinedReverseStatusEnabled:
 		autodefinedReverseFlag = awstypes.AutodefinedReverseFlagEnable
-	} else {
+	case awstypes.ResolverAutodefinedReverseStatusUseLocalResourceSetting:
+		autodefinedReverseFlag = awstypes.AutodefinedReverseFlagUseLocalResourceSetting
+	default:
 		autodefinedReverseFlag = awstypes.AutodefinedReverseFlagDisable
 	}
 	d.Set("autodefined_reverse_flag", autodefinedReverseFlag)
@@ -180,9 +183,12 @@ const (
 )
 
 func waitAutodefinedReverseUpdated(ctx context.Context, conn *route53resolver.Client, id string, autodefinedReverseFlag awstypes.AutodefinedReverseFlag) (*awstypes.ResolverConfig, error) {
-	if autodefinedReverseFlag == awstypes.AutodefinedReverseFlagDisable {
+	switch autodefinedReverseFlag {
+	case awstypes.AutodefinedReverseFlagDisable:
 		return waitAutodefinedReverseDisabled(ctx, conn, id)
-	} else {
+	case awstypes.AutodefinedReverseFlagUseLocalResourceSetting:
+		return waitAutodefinedReverseUseLocalResourceSetting(ctx, conn, id)
+	default:
 		return waitAutodefinedReverseEnabled(ctx, conn, id)
 	}
 }
@@ -220,3 +226,20 @@ func waitAutodefinedReverseDisabled(ctx context.Context, conn *route53resolver.C
 
 	return nil, err
 }
+
+func waitAutodefinedReverseUseLocalResourceSetting(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverConfig, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResolverAutodefinedReverseStatusUpdatingToUseLocalResourceSetting),
+		Target:  enum.Slice(awstypes.ResolverAutodefinedReverseStatusUseLocalResourceSetting),
+		Refresh: statusAutodefinedReverse(ctx, conn, id),
+		Timeout: autodefinedReverseUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ResolverConfig); ok {
+		return output, err
+	}
+
+	return nil, err
+}
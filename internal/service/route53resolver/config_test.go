@@ -55,6 +55,13 @@ func TestAccRoute53ResolverConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceID, vpcResourceName, names.AttrID),
 				),
 			},
+			{
+				Config: testAccConfigConfig_basic(rName, "USE_LOCAL_RESOURCE_SETTING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "autodefined_reverse_flag", string(awstypes.AutodefinedReverseFlagUseLocalResourceSetting)),
+				),
+			},
 		},
 	})
 }
@@ -30,6 +30,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	endpointProtocolDoH     = "DoH"
+	endpointProtocolDoHFIPS = "DoH-FIPS"
+)
+
 // @SDKResource("aws_route53_resolver_endpoint", name="Endpoint")
 // @Tags(identifierAttribute="arn")
 func resourceEndpoint() *schema.Resource {
@@ -128,6 +133,8 @@ func resourceEndpoint() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+
+		CustomizeDiff: resourceEndpointCustomizeDiff,
 	}
 }
 
@@ -309,6 +316,26 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
+func resourceEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v any) error {
+	if !diff.NewValueKnown("protocols") {
+		return nil
+	}
+
+	protocols := diff.Get("protocols").(*schema.Set)
+	doh, dohFIPS := protocols.Contains(endpointProtocolDoH), protocols.Contains(endpointProtocolDoHFIPS)
+
+	if doh && dohFIPS {
+		return fmt.Errorf(`"protocols" cannot contain both %q and %q`, endpointProtocolDoH, endpointProtocolDoHFIPS)
+	}
+
+	// DoH-FIPS is only supported for inbound endpoints.
+	if dohFIPS && awstypes.ResolverEndpointDirection(diff.Get("direction").(string)) == awstypes.ResolverEndpointDirectionOutbound {
+		return fmt.Errorf(`"protocols" cannot contain %q for %s endpoints`, endpointProtocolDoHFIPS, awstypes.ResolverEndpointDirectionOutbound)
+	}
+
+	return nil
+}
+
 func findResolverEndpointByID(ctx context.Context, conn *route53resolver.Client, id string) (*awstypes.ResolverEndpoint, error) {
 	input := &route53resolver.GetResolverEndpointInput{
 		ResolverEndpointId: aws.String(id),
@@ -41,6 +41,11 @@ func resourceDiscoverer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cross_account": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -64,8 +69,9 @@ func resourceDiscovererCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	sourceARN := d.Get("source_arn").(string)
 	input := &schemas.CreateDiscovererInput{
-		SourceArn: aws.String(sourceARN),
-		Tags:      getTagsIn(ctx),
+		CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
+		SourceArn:    aws.String(sourceARN),
+		Tags:         getTagsIn(ctx),
 	}
 
 	if v, ok := d.GetOk(names.AttrDescription); ok {
@@ -100,6 +106,7 @@ func resourceDiscovererRead(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	d.Set(names.AttrARN, output.DiscovererArn)
+	d.Set("cross_account", output.CrossAccount)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("source_arn", output.SourceArn)
 
@@ -110,10 +117,11 @@ func resourceDiscovererUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchemasClient(ctx)
 
-	if d.HasChange(names.AttrDescription) {
+	if d.HasChanges("cross_account", names.AttrDescription) {
 		input := &schemas.UpdateDiscovererInput{
-			DiscovererId: aws.String(d.Id()),
+			CrossAccount: aws.Bool(d.Get("cross_account").(bool)),
 			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			DiscovererId: aws.String(d.Id()),
 		}
 
 		_, err := conn.UpdateDiscoverer(ctx, input)
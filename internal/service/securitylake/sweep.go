@@ -16,7 +16,8 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_securitylake_data_lake", sweepDataLakes)
+	awsv2.Register("aws_securitylake_data_lake", sweepDataLakes, "aws_securitylake_subscriber")
+	awsv2.Register("aws_securitylake_subscriber", sweepSubscribers)
 }
 
 func sweepDataLakes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -37,3 +38,25 @@ func sweepDataLakes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 
 	return sweepResources, nil
 }
+
+func sweepSubscribers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.SecurityLakeClient(ctx)
+	var input securitylake.ListSubscribersInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := securitylake.NewListSubscribersPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Subscribers {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newSubscriberResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.SubscriberId))))
+		}
+	}
+
+	return sweepResources, nil
+}
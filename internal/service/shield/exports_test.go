@@ -16,4 +16,5 @@ var (
 	FindDRTRoleARNAssociation                          = findDRTRoleARNAssociation
 	FindEmergencyContactSettings                       = findEmergencyContactSettings
 	FindProtectionByID                                 = findProtectionByID
+	FindProtectionHealthCheckAssociationByTwoPartKey   = findProtectionHealthCheckAssociationByTwoPartKey
 )
@@ -5,18 +5,23 @@ package shield
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_shield_protection_health_check_association", name="Protection Health Check Association")
@@ -36,9 +41,10 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"health_check_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
 			},
 		},
 	}
@@ -75,14 +81,10 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "parsing Shield Protection and Route53 Health Check Association ID: %s", err)
 	}
 
-	input := &shield.DescribeProtectionInput{
-		ProtectionId: aws.String(protectionId),
-	}
-
-	resp, err := conn.DescribeProtection(ctx, input)
+	protection, err := findProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, protectionId, healthCheckArn)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		log.Printf("[WARN] Shield Protection itself (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
@@ -91,14 +93,8 @@ func ResourceProtectionHealthCheckAssociationRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "reading Shield Protection Health Check Association (%s): %s", d.Id(), err)
 	}
 
-	isHealthCheck := stringInSlice(strings.Split(healthCheckArn, "/")[1], resp.Protection.HealthCheckIds)
-	if !isHealthCheck {
-		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-	}
-
 	d.Set("health_check_arn", healthCheckArn)
-	d.Set("shield_protection_id", resp.Protection.Id)
+	d.Set("shield_protection_id", protection.Id)
 
 	return diags
 }
@@ -120,12 +116,51 @@ func ResourceProtectionHealthCheckAssociationDelete(ctx context.Context, d *sche
 
 	_, err = conn.DisassociateHealthCheck(ctx, input)
 
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
 	}
 	return diags
 }
 
-func stringInSlice(expected string, list []string) bool {
-	return slices.Contains(list, expected)
+// findProtectionHealthCheckAssociationByTwoPartKey returns the protection if the health check is one of the
+// possibly several health checks associated with it.
+func findProtectionHealthCheckAssociationByTwoPartKey(ctx context.Context, conn *shield.Client, protectionID, healthCheckARN string) (*awstypes.Protection, error) {
+	healthCheckID, err := healthCheckIDFromARN(healthCheckARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	protection, err := findProtectionByID(ctx, conn, protectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(protection.HealthCheckIds, healthCheckID) {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return protection, nil
+}
+
+// healthCheckIDFromARN returns the ID of the Route 53 health check from its ARN, e.g. "arn:aws:route53:::healthcheck/<id>".
+func healthCheckIDFromARN(v string) (string, error) {
+	healthCheckARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	resourceType, id, ok := strings.Cut(healthCheckARN.Resource, "/")
+
+	if !ok || resourceType != "healthcheck" || id == "" {
+		return "", fmt.Errorf("unexpected format for Route53 Health Check ARN (%s)", v)
+	}
+
+	return id, nil
 }
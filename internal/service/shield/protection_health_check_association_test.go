@@ -8,16 +8,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/shield"
-	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -78,6 +75,38 @@ func TestAccShieldProtectionHealthCheckAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccShieldProtectionHealthCheckAssociation_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_shield_protection_health_check_association.test"
+	resource2Name := "aws_shield_protection_health_check_association.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ShieldEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ShieldServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProtectionHealthCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProtectionHealthCheckAssociationConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionHealthCheckAssociationExists(ctx, resource1Name),
+					testAccCheckProtectionHealthCheckAssociationExists(ctx, resource2Name),
+				),
+			},
+			{
+				ResourceName:      resource2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckProtectionHealthCheckAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldClient(ctx)
@@ -87,19 +116,15 @@ func testAccCheckProtectionHealthCheckAssociationDestroy(ctx context.Context) re
 				continue
 			}
 
-			protectionId, _, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
+			protectionId, healthCheckArn, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
 
 			if err != nil {
 				return err
 			}
 
-			input := &shield.DescribeProtectionInput{
-				ProtectionId: aws.String(protectionId),
-			}
-
-			resp, err := conn.DescribeProtection(ctx, input)
+			_, err = tfshield.FindProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, protectionId, healthCheckArn)
 
-			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -107,9 +132,7 @@ func testAccCheckProtectionHealthCheckAssociationDestroy(ctx context.Context) re
 				return err
 			}
 
-			if resp != nil && resp.Protection != nil && len(resp.Protection.HealthCheckIds) == 0 {
-				return fmt.Errorf("The Shield protection HealthCheck with IDs %v still exists", resp.Protection.HealthCheckIds)
-			}
+			return fmt.Errorf("Shield Protection Health Check Association %s still exists", rs.Primary.ID)
 		}
 
 		return nil
@@ -123,11 +146,7 @@ func testAccCheckProtectionHealthCheckAssociationExists(ctx context.Context, res
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No Shield Protection and Route53 Health Check Association ID is set")
-		}
-
-		protectionId, _, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
+		protectionId, healthCheckArn, err := tfshield.ProtectionHealthCheckAssociationParseResourceID(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -135,25 +154,9 @@ func testAccCheckProtectionHealthCheckAssociationExists(ctx context.Context, res
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldClient(ctx)
 
-		input := &shield.DescribeProtectionInput{
-			ProtectionId: aws.String(protectionId),
-		}
-
-		resp, err := conn.DescribeProtection(ctx, input)
-
-		if err != nil {
-			return err
-		}
-
-		if resp == nil || resp.Protection == nil {
-			return fmt.Errorf("The Shield protection does not exist")
-		}
+		_, err = tfshield.FindProtectionHealthCheckAssociationByTwoPartKey(ctx, conn, protectionId, healthCheckArn)
 
-		if resp.Protection.HealthCheckIds == nil || len(resp.Protection.HealthCheckIds) != 1 {
-			return fmt.Errorf("The Shield protection HealthCheck does not exist")
-		}
-
-		return nil
+		return err
 	}
 }
 
@@ -200,3 +203,23 @@ resource "aws_shield_protection_health_check_association" "test" {
 }
 `, rName)
 }
+
+func testAccProtectionHealthCheckAssociationConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccProtectionHealthCheckAssociationConfig_protectionaHealthCheckAssociation(rName), `
+resource "aws_route53_health_check" "test2" {
+  fqdn              = "example.org"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "5"
+  request_interval  = "30"
+  tags = {
+    Name = "tf-test-health-check"
+  }
+}
+resource "aws_shield_protection_health_check_association" "test2" {
+  shield_protection_id = aws_shield_protection.test.id
+  health_check_arn     = aws_route53_health_check.test2.arn
+}
+`)
+}
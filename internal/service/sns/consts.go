@@ -36,6 +36,36 @@ const (
 )
 */
 
+const (
+	dataProtectionPolicyVersion = "2021-06-01"
+)
+
+const (
+	dataProtectionPolicyDataDirectionInbound  = "Inbound"
+	dataProtectionPolicyDataDirectionOutbound = "Outbound"
+)
+
+func dataProtectionPolicyDataDirection_Values() []string {
+	return []string{
+		dataProtectionPolicyDataDirectionInbound,
+		dataProtectionPolicyDataDirectionOutbound,
+	}
+}
+
+const (
+	dataProtectionPolicyOperationAudit      = "Audit"
+	dataProtectionPolicyOperationDeidentify = "Deidentify"
+	dataProtectionPolicyOperationDeny       = "Deny"
+)
+
+func dataProtectionPolicyOperation_Values() []string {
+	return []string{
+		dataProtectionPolicyOperationAudit,
+		dataProtectionPolicyOperationDeidentify,
+		dataProtectionPolicyOperationDeny,
+	}
+}
+
 const (
 	subscriptionProtocolApplication = "application"
 	subscriptionProtocolEmail       = names.AttrEmail
@@ -21,6 +21,7 @@ var (
 	ParsePlatformApplicationResourceID = parsePlatformApplicationResourceID
 	TopicAttributeNameDeliveryPolicy   = topicAttributeNameDeliveryPolicy
 	TopicAttributeNamePolicy           = topicAttributeNamePolicy
+	ValidDataProtectionPolicy          = validDataProtectionPolicy
 
 	SubscriptionProtocolApplication = subscriptionProtocolApplication
 	SubscriptionProtocolHTTP        = subscriptionProtocolHTTP
@@ -5,7 +5,10 @@ package sns
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
@@ -43,7 +46,7 @@ func resourceTopicDataProtectionPolicy() *schema.Resource {
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
+				ValidateFunc:          validation.All(validation.StringIsJSON, validDataProtectionPolicy),
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v any) string {
@@ -141,3 +144,72 @@ func findDataProtectionPolicyByARN(ctx context.Context, conn *sns.Client, arn st
 
 	return output.DataProtectionPolicy, nil
 }
+
+type dataProtectionPolicy struct {
+	Name      string                          `json:"Name"`
+	Statement []dataProtectionPolicyStatement `json:"Statement"`
+	Version   string                          `json:"Version"`
+}
+
+type dataProtectionPolicyStatement struct {
+	DataDirection  string         `json:"DataDirection"`
+	DataIdentifier []string       `json:"DataIdentifier"`
+	Operation      map[string]any `json:"Operation"`
+	Principal      []string       `json:"Principal"`
+	Sid            string         `json:"Sid"`
+}
+
+// validDataProtectionPolicy validates the structure of a data protection policy document so that
+// malformed policies are reported during plan rather than by the PutDataProtectionPolicy API.
+func validDataProtectionPolicy(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var policy dataProtectionPolicy
+
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid data protection policy: %w", k, err))
+		return
+	}
+
+	if policy.Name == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a Name", k))
+	}
+
+	if policy.Version != dataProtectionPolicyVersion {
+		errors = append(errors, fmt.Errorf("%q Version must be %q, got %q", k, dataProtectionPolicyVersion, policy.Version))
+	}
+
+	if len(policy.Statement) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one Statement", k))
+	}
+
+	for i, statement := range policy.Statement {
+		if !slices.Contains(dataProtectionPolicyDataDirection_Values(), statement.DataDirection) {
+			errors = append(errors, fmt.Errorf("%q Statement[%d] DataDirection must be one of %v, got %q", k, i, dataProtectionPolicyDataDirection_Values(), statement.DataDirection))
+		}
+
+		if len(statement.DataIdentifier) == 0 {
+			errors = append(errors, fmt.Errorf("%q Statement[%d] must contain at least one DataIdentifier", k, i))
+		}
+
+		if len(statement.Principal) == 0 {
+			errors = append(errors, fmt.Errorf("%q Statement[%d] must contain at least one Principal", k, i))
+		}
+
+		if n := len(statement.Operation); n != 1 {
+			errors = append(errors, fmt.Errorf("%q Statement[%d] Operation must contain exactly one of %v", k, i, dataProtectionPolicyOperation_Values()))
+		} else {
+			for operation := range statement.Operation {
+				if !slices.Contains(dataProtectionPolicyOperation_Values(), operation) {
+					errors = append(errors, fmt.Errorf("%q Statement[%d] Operation must be one of %v, got %q", k, i, dataProtectionPolicyOperation_Values(), operation))
+				}
+			}
+		}
+	}
+
+	return
+}
@@ -19,6 +19,62 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidDataProtectionPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value   string
+		IsValid bool
+	}{
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Inbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deny":{}},"Principal":["*"]}]}`,
+			IsValid: true,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Outbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deidentify":{"MaskConfig":{}}},"Principal":["*"]}]}`,
+			IsValid: true,
+		},
+		{
+			Value:   `{"Version":"2021-06-01","Statement":[{"DataDirection":"Inbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deny":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2012-10-17","Statement":[{"DataDirection":"Inbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deny":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Sideways","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deny":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Inbound","DataIdentifier":[],"Operation":{"Deny":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Inbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Deny":{},"Audit":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+		{
+			Value:   `{"Name":"example","Version":"2021-06-01","Statement":[{"DataDirection":"Inbound","DataIdentifier":["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],"Operation":{"Block":{}},"Principal":["*"]}]}`,
+			IsValid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := tfsns.ValidDataProtectionPolicy(tc.Value, names.AttrPolicy)
+		isValid := len(errors) == 0
+		if tc.IsValid && !isValid {
+			t.Errorf("expected %q to return valid, but did not: %v", tc.Value, errors)
+		} else if !tc.IsValid && isValid {
+			t.Errorf("expected %q to not return valid, but did", tc.Value)
+		}
+	}
+}
+
 func TestAccSNSTopicDataProtectionPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
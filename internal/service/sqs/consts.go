@@ -40,6 +40,20 @@ func fifoThroughputLimit_Values() []string {
 	}
 }
 
+const (
+	redrivePermissionAllowAll = "allowAll"
+	redrivePermissionByQueue  = "byQueue"
+	redrivePermissionDenyAll  = "denyAll"
+)
+
+func redrivePermission_Values() []string {
+	return []string{
+		redrivePermissionAllowAll,
+		redrivePermissionByQueue,
+		redrivePermissionDenyAll,
+	}
+}
+
 const (
 	errCodeQueueDoesNotExist     = "AWS.SimpleQueueService.NonExistentQueue"
 	errCodeQueueDeletedRecently  = "AWS.SimpleQueueService.QueueDeletedRecently"
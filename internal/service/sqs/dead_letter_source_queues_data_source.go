@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_sqs_dead_letter_source_queues", name="Dead Letter Source Queues")
+func dataSourceDeadLetterSourceQueues() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeadLetterSourceQueuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_queue_urls": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDeadLetterSourceQueuesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url := d.Get("queue_url").(string)
+	input := &sqs.ListDeadLetterSourceQueuesInput{
+		QueueUrl: aws.String(url),
+	}
+
+	var queueURLs []string
+	pages := sqs.NewListDeadLetterSourceQueuesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing SQS Queue (%s) dead-letter source queues: %s", url, err)
+		}
+
+		queueURLs = append(queueURLs, page.QueueUrls...)
+	}
+
+	d.SetId(url)
+	d.Set("source_queue_urls", queueURLs)
+
+	return diags
+}
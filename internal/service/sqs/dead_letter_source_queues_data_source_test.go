@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSDeadLetterSourceQueuesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sqs_dead_letter_source_queues.test"
+	sourceResourceName := "aws_sqs_queue.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeadLetterSourceQueuesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "queue_url", "aws_sqs_queue.dlq", names.AttrURL),
+					resource.TestCheckResourceAttr(dataSourceName, "source_queue_urls.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "source_queue_urls.*", sourceResourceName, names.AttrURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeadLetterSourceQueuesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_sqs_queue" "source" {
+  name = %[1]q
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 4
+  })
+}
+
+data "aws_sqs_dead_letter_source_queues" "test" {
+  queue_url = aws_sqs_queue.dlq.url
+
+  depends_on = [aws_sqs_queue.source]
+}
+`, rName)
+}
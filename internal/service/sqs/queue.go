@@ -5,10 +5,12 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -133,15 +135,41 @@ var (
 			Default:  defaultQueueReceiveMessageWaitTimeSeconds,
 		},
 		"redrive_allow_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringIsJSON,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ValidateFunc:  validation.StringIsJSON,
+			ConflictsWith: []string{"redrive_allow_policy_configuration"},
 			StateFunc: func(v any) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
 			},
 		},
+		"redrive_allow_policy_configuration": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"redrive_allow_policy"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"redrive_permission": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(redrivePermission_Values(), false),
+					},
+					"source_queue_arns": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MaxItems: 10,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
 		"redrive_policy": {
 			Type:         schema.TypeString,
 			Optional:     true,
@@ -234,6 +262,17 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if v, ok := d.GetOk("redrive_allow_policy_configuration"); ok {
+		policy, err := expandRedriveAllowPolicy(v.([]any))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if policy != "" {
+			attributes[types.QueueAttributeNameRedriveAllowPolicy] = policy
+		}
+	}
+
 	input.Attributes = flex.ExpandStringyValueMap(attributes)
 
 	// create is 2 phase: 1. create, 2. wait for propagation
@@ -307,6 +346,14 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	redriveAllowPolicy, err := flattenRedriveAllowPolicy(output[types.QueueAttributeNameRedriveAllowPolicy])
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	if err := d.Set("redrive_allow_policy_configuration", redriveAllowPolicy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting redrive_allow_policy_configuration: %s", err)
+	}
+
 	// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
 	if d.Get("kms_data_key_reuse_period_seconds").(int) == 0 {
 		d.Set("kms_data_key_reuse_period_seconds", defaultQueueKMSDataKeyReusePeriodSeconds)
@@ -333,6 +380,24 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
+		// When not configured, SQS decides whether SSE-SQS is enabled, e.g. it's disabled when a KMS key is configured.
+		if v := d.GetRawConfig().GetAttr("sqs_managed_sse_enabled"); v.IsKnown() && v.IsNull() {
+			delete(attributes, types.QueueAttributeNameSqsManagedSseEnabled)
+		}
+
+		if v, ok := d.GetOk("redrive_allow_policy_configuration"); ok && d.HasChange("redrive_allow_policy_configuration") {
+			policy, err := expandRedriveAllowPolicy(v.([]any))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			attributes[types.QueueAttributeNameRedriveAllowPolicy] = policy
+		}
+
+		if len(attributes) == 0 {
+			return append(diags, resourceQueueRead(ctx, d, meta)...)
+		}
+
 		input := &sqs.SetQueueAttributesInput{
 			Attributes: flex.ExpandStringyValueMap(attributes),
 			QueueUrl:   aws.String(d.Id()),
@@ -415,9 +480,92 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	// If SSE-SQS isn't configured its value is determined by SQS, which changes along with the KMS key.
+	if diff.Id() != "" && diff.HasChange("kms_master_key_id") {
+		if v := diff.GetRawConfig().GetAttr("sqs_managed_sse_enabled"); v.IsKnown() && v.IsNull() {
+			if err := diff.SetNewComputed("sqs_managed_sse_enabled"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// redrive_allow_policy and redrive_allow_policy_configuration are two views of the same queue attribute.
+	if diff.Id() != "" {
+		if diff.HasChange("redrive_allow_policy") {
+			if err := diff.SetNewComputed("redrive_allow_policy_configuration"); err != nil {
+				return err
+			}
+		} else if diff.HasChange("redrive_allow_policy_configuration") {
+			if err := diff.SetNewComputed("redrive_allow_policy"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := diff.GetOk("redrive_allow_policy_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		permission := tfMap["redrive_permission"].(string)
+		n := tfMap["source_queue_arns"].(*schema.Set).Len()
+
+		if permission == redrivePermissionByQueue && n == 0 {
+			return fmt.Errorf(`"redrive_allow_policy_configuration.0.source_queue_arns" must be specified when "redrive_permission" is %q`, redrivePermissionByQueue)
+		}
+
+		if permission != redrivePermissionByQueue && n > 0 {
+			return fmt.Errorf(`"redrive_allow_policy_configuration.0.source_queue_arns" can only be specified when "redrive_permission" is %q`, redrivePermissionByQueue)
+		}
+	}
+
 	return nil
 }
 
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns,omitempty"`
+}
+
+// expandRedriveAllowPolicy returns the JSON form of a redrive_allow_policy_configuration block, or "" if none is configured.
+func expandRedriveAllowPolicy(tfList []any) (string, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := redriveAllowPolicy{
+		RedrivePermission: tfMap["redrive_permission"].(string),
+	}
+
+	if v, ok := tfMap["source_queue_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SourceQueueARNs = flex.ExpandStringValueSet(v)
+		slices.Sort(apiObject.SourceQueueARNs)
+	}
+
+	b, err := json.Marshal(apiObject)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenRedriveAllowPolicy(s string) ([]any, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var apiObject redriveAllowPolicy
+	if err := json.Unmarshal([]byte(s), &apiObject); err != nil {
+		return nil, fmt.Errorf("parsing redrive allow policy (%s): %w", s, err)
+	}
+
+	tfMap := map[string]any{
+		"redrive_permission": apiObject.RedrivePermission,
+		"source_queue_arns":  apiObject.SourceQueueARNs,
+	}
+
+	return []any{tfMap}, nil
+}
+
 func queueName(d sdkv2.ResourceDiffer) string {
 	optFns := []create.NameGeneratorOptionsFunc{create.WithConfiguredName(d.Get(names.AttrName).(string)), create.WithConfiguredPrefix(d.Get(names.AttrNamePrefix).(string))}
 	if d.Get("fifo_queue").(bool) {
@@ -125,6 +125,7 @@ func TestAccSQSQueue_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "receive_wait_time_seconds", strconv.Itoa(tfsqs.DefaultQueueReceiveMessageWaitTimeSeconds)),
 					resource.TestCheckResourceAttr(resourceName, "redrive_policy", ""),
 					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrURL, resourceName, names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", strconv.Itoa(tfsqs.DefaultQueueVisibilityTimeout)),
 				),
@@ -540,6 +541,8 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
 					resource.TestCheckResourceAttr(resourceName, "delay_seconds", "0"),
 					//resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.0.redrive_permission", "byQueue"),
 					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "300"),
 				),
 			},
@@ -552,6 +555,56 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_redriveAllowPolicyConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redriveAllowPolicyConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.0.redrive_permission", "byQueue"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.0.source_queue_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "redrive_allow_policy_configuration.0.source_queue_arns.*", "aws_sqs_queue.dlq", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_redriveAllowPolicyConfigurationDenyAll(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.0.redrive_permission", "denyAll"),
+					resource.TestCheckResourceAttr(resourceName, "redrive_allow_policy_configuration.0.source_queue_arns.#", "0"),
+				),
+			},
+			{
+				// Switching back to the JSON argument with equivalent content is a no-op.
+				Config: testAccQueueConfig_redriveAllowPolicyDenyAll(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -776,6 +829,43 @@ func TestAccSQSQueue_managedEncryption(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_ManagedEncryption_unconfiguredToKMS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[types.QueueAttributeName]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_managedEncryption(rName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "sqs_managed_sse_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccQueueConfig_encryption(rName, "null"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("sqs_managed_sse_enabled")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "kms_master_key_id", "alias/aws/sqs"),
+					resource.TestCheckResourceAttr(resourceName, "sqs_managed_sse_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_zeroVisibilityTimeoutSeconds(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -1228,6 +1318,47 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccQueueConfig_redriveAllowPolicyDenyAll(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "denyAll"
+  })
+}
+`, rName)
+}
+
+func testAccQueueConfig_redriveAllowPolicyConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_allow_policy_configuration {
+    redrive_permission = "byQueue"
+    source_queue_arns  = [aws_sqs_queue.dlq.arn]
+  }
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccQueueConfig_redriveAllowPolicyConfigurationDenyAll(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_allow_policy_configuration {
+    redrive_permission = "denyAll"
+  }
+}
+`, rName)
+}
+
 func testAccQueueConfig_fifo(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -27,6 +27,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
 	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceDeadLetterSourceQueues,
+			TypeName: "aws_sqs_dead_letter_source_queues",
+			Name:     "Dead Letter Source Queues",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceQueue,
 			TypeName: "aws_sqs_queue",
@@ -5,6 +5,7 @@ package ssm
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -19,9 +20,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// The expiration date that SSM applies to an activation when none is requested.
+	defaultActivationValidityPeriod = 24 * time.Hour
+)
+
 // @SDKResource("aws_ssm_activation", name="Activation")
 // @Tags
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssm/types;awstypes;awstypes.Activation")
@@ -32,6 +39,7 @@ func resourceActivation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceActivationCreate,
 		ReadWithoutTimeout:   resourceActivationRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow auto_renew update.
 		DeleteWithoutTimeout: resourceActivationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -43,6 +51,26 @@ func resourceActivation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_renew": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"expiration_date"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"renew_before": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidDuration,
+						},
+						"validity_period": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -78,9 +106,17 @@ func resourceActivation() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"registration_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchemaForceNew(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: resourceActivationCustomizeDiff,
 	}
 }
 
@@ -102,12 +138,19 @@ func resourceActivationCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, ok := d.GetOk("expiration_date"); ok {
 		t, _ := time.Parse(time.RFC3339, v.(string))
 		input.ExpirationDate = aws.Time(t)
+	} else if v, ok := d.GetOk("auto_renew.0.validity_period"); ok {
+		duration, _ := time.ParseDuration(v.(string))
+		input.ExpirationDate = aws.Time(time.Now().Add(duration))
 	}
 
 	if v, ok := d.GetOk("registration_limit"); ok {
 		input.RegistrationLimit = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("registration_metadata"); ok && len(v.(map[string]any)) > 0 {
+		input.RegistrationMetadata = expandRegistrationMetadataItems(v.(map[string]any))
+	}
+
 	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
 		return conn.CreateActivation(ctx, input)
 	}, errCodeValidationException, "Nonexistent role")
@@ -173,6 +216,49 @@ func resourceActivationDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceActivationCustomizeDiff plans replacement of an auto-renewed activation
+// once its expiration date falls within the configured renewal window.
+func resourceActivationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := d.GetOk("auto_renew.0.renew_before")
+	if !ok || !d.NewValueKnown("auto_renew.0.renew_before") || !d.NewValueKnown("auto_renew.0.validity_period") {
+		return nil
+	}
+
+	renewBefore, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return err
+	}
+
+	validityPeriod := defaultActivationValidityPeriod
+	if v, ok := d.GetOk("auto_renew.0.validity_period"); ok {
+		if validityPeriod, err = time.ParseDuration(v.(string)); err != nil {
+			return err
+		}
+	}
+
+	// Otherwise every new activation would already be within its renewal window.
+	if renewBefore >= validityPeriod {
+		return fmt.Errorf("auto_renew.0.renew_before (%s) must be less than auto_renew.0.validity_period (%s)", renewBefore, validityPeriod)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	o, _ := d.GetChange("expiration_date")
+	expirationDate, err := time.Parse(time.RFC3339, o.(string))
+	if err != nil {
+		return nil
+	}
+
+	if time.Now().Add(renewBefore).Before(expirationDate) {
+		return nil
+	}
+
+	// expiration_date is ForceNew, so a new computed value replaces the activation.
+	return d.SetNewComputed("expiration_date")
+}
+
 func findActivationByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Activation, error) {
 	input := &ssm.DescribeActivationsInput{
 		Filters: []awstypes.DescribeActivationsFilter{
@@ -212,3 +298,16 @@ func findActivations(ctx context.Context, conn *ssm.Client, input *ssm.DescribeA
 
 	return output, nil
 }
+
+func expandRegistrationMetadataItems(tfMap map[string]any) []awstypes.RegistrationMetadataItem {
+	apiObjects := make([]awstypes.RegistrationMetadataItem, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, awstypes.RegistrationMetadataItem{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
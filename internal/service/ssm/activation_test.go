@@ -9,10 +9,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -93,6 +95,104 @@ func TestAccSSMActivation_expirationDate(t *testing.T) {
 	})
 }
 
+func TestAccSSMActivation_autoRenew(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ssmActivation awstypes.Activation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivationConfig_autoRenew(rName, roleName, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActivationExists(ctx, resourceName, &ssmActivation),
+					resource.TestCheckResourceAttrSet(resourceName, "activation_code"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew.0.renew_before", "1h"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew.0.validity_period", "48h"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"activation_code",
+					"auto_renew",
+				},
+			},
+			{
+				Config:      testAccActivationConfig_autoRenew(rName, roleName, "72h"),
+				ExpectError: regexache.MustCompile(`must be less than auto_renew.0.validity_period`),
+			},
+			{
+				// The expiration date is still outside the renewal window, so the activation is kept.
+				Config: testAccActivationConfig_autoRenew(rName, roleName, "47h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActivationExists(ctx, resourceName, &ssmActivation),
+					resource.TestCheckResourceAttrSet(resourceName, "activation_code"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew.0.renew_before", "47h"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMActivation_registrationMetadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ssmActivation awstypes.Activation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivationConfig_registrationMetadata(rName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActivationExists(ctx, resourceName, &ssmActivation),
+					resource.TestCheckResourceAttr(resourceName, "registration_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "registration_metadata.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "registration_metadata.Fleet", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"activation_code",
+					"registration_metadata",
+				},
+			},
+		},
+	})
+}
+
 func TestAccSSMActivation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ssmActivation awstypes.Activation
@@ -222,3 +322,39 @@ resource "aws_ssm_activation" "test" {
 }
 `, rName, expirationDate))
 }
+
+func testAccActivationConfig_autoRenew(rName, roleName, renewBefore string) string {
+	return acctest.ConfigCompose(testAccActivationConfig_base(roleName), fmt.Sprintf(`
+resource "aws_ssm_activation" "test" {
+  name               = %[1]q
+  description        = "Test"
+  iam_role           = aws_iam_role.test.name
+  registration_limit = "5"
+
+  auto_renew {
+    renew_before    = %[2]q
+    validity_period = "48h"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, renewBefore))
+}
+
+func testAccActivationConfig_registrationMetadata(rName, roleName string) string {
+	return acctest.ConfigCompose(testAccActivationConfig_base(roleName), fmt.Sprintf(`
+resource "aws_ssm_activation" "test" {
+  name               = %[1]q
+  description        = "Test"
+  iam_role           = aws_iam_role.test.name
+  registration_limit = "5"
+
+  registration_metadata = {
+    Environment = "test"
+    Fleet       = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -72,6 +73,11 @@ func resourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"compliance_severity": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -133,6 +139,11 @@ func resourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			"schedule_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
 			"sync_compliance": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -140,6 +151,47 @@ func resourceAssociation() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -191,6 +243,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.CalendarNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 	}
@@ -219,10 +275,18 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		input.ScheduleOffset = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("sync_compliance"); ok {
 		input.SyncCompliance = awstypes.AssociationSyncCompliance(v.(string))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]any)) > 0 {
+		input.TargetLocations = expandTargetLocations(v.([]any))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		input.Targets = expandTargets(v.([]any))
 	}
@@ -273,6 +337,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta a
 	d.Set(names.AttrAssociationID, association.AssociationId)
 	d.Set("association_name", association.AssociationName)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("calendar_names", association.CalendarNames)
 	d.Set("compliance_severity", association.ComplianceSeverity)
 	d.Set("document_version", association.DocumentVersion)
 	d.Set("max_concurrency", association.MaxConcurrency)
@@ -285,7 +350,11 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
 	d.Set(names.AttrScheduleExpression, association.ScheduleExpression)
+	d.Set("schedule_offset", association.ScheduleOffset)
 	d.Set("sync_compliance", association.SyncCompliance)
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_locations: %s", err)
+	}
 	if err := d.Set("targets", flattenTargets(association.Targets)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
 	}
@@ -315,6 +384,12 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.AutomationTargetParameterName = aws.String(v.(string))
 		}
 
+		if d.HasChange("calendar_names") {
+			input.CalendarNames = flex.ExpandStringValueEmptySet(d.Get("calendar_names").(*schema.Set))
+		} else if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+			input.CalendarNames = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
 		if v, ok := d.GetOk("compliance_severity"); ok {
 			input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 		}
@@ -343,10 +418,21 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.ScheduleExpression = aws.String(v.(string))
 		}
 
+		// UpdateAssociation resets optional parameters that are omitted, so a removed schedule_offset is cleared.
+		if v, ok := d.GetOk("schedule_offset"); ok {
+			input.ScheduleOffset = aws.Int32(int32(v.(int)))
+		}
+
 		if d.HasChange("sync_compliance") {
 			input.SyncCompliance = awstypes.AssociationSyncCompliance(d.Get("sync_compliance").(string))
 		}
 
+		if d.HasChange("target_locations") {
+			input.TargetLocations = expandTargetLocations(d.Get("target_locations").([]any))
+		} else if v, ok := d.GetOk("target_locations"); ok && len(v.([]any)) > 0 {
+			input.TargetLocations = expandTargetLocations(v.([]any))
+		}
+
 		if _, ok := d.GetOk("targets"); ok {
 			input.Targets = expandTargets(d.Get("targets").([]any))
 		}
@@ -504,3 +590,56 @@ func flattenAssociationOutputLocation(apiObject *awstypes.InstanceAssociationOut
 
 	return tfList
 }
+
+func expandTargetLocations(tfList []any) []awstypes.TargetLocation {
+	apiObjects := make([]awstypes.TargetLocation, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []awstypes.TargetLocation) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"accounts":                        apiObject.Accounts,
+			"execution_role_name":             aws.ToString(apiObject.ExecutionRoleName),
+			"regions":                         apiObject.Regions,
+			"target_location_max_concurrency": aws.ToString(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.ToString(apiObject.TargetLocationMaxErrors),
+		})
+	}
+
+	return tfList
+}
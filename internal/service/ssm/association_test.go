@@ -638,6 +638,97 @@ func TestAccSSMAssociation_syncCompliance(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_scheduleOffset(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_scheduleOffset(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "apply_only_at_cron_interval", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_scheduleOffset(rName, "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "3"),
+				),
+			},
+			{
+				Config: testAccAssociationConfig_scheduleOffset(rName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_locations.0.execution_role_name", "aws_iam_role.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "2"),
+				),
+			},
+			{
+				Config: testAccAssociationConfig_targetLocationsRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1621,6 +1712,119 @@ resource "aws_ssm_association" "test" {
 `, rName, syncCompliance)
 }
 
+func testAccAssociationConfig_scheduleOffset(rName, scheduleOffset string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Check ip configuration of a Linux instance."
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["ifconfig"]
+      }
+    }]
+  })
+}
+
+resource "aws_ssm_association" "test" {
+  name                        = aws_ssm_document.test.name
+  apply_only_at_cron_interval = true
+  calendar_names              = [aws_ssm_document.calendar.arn]
+  schedule_expression         = "cron(0 16 ? * TUE#3 *)"
+  schedule_offset             = %[2]s
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, scheduleOffset)
+}
+
+func testAccAssociationConfig_targetLocations(rName, rate string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonSSMAutomationRole"
+}
+
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-StopEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  target_locations {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    execution_role_name             = aws_iam_role.test.name
+    regions                         = [data.aws_region.current.region]
+    target_location_max_concurrency = %[2]q
+    target_location_max_errors      = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, rate)
+}
+
+func testAccAssociationConfig_targetLocationsRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-StopEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+}
+`, rName)
+}
+
 func testAccAssociationConfig_outputLocationAndWaitForSuccess(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAssociationWithOutputLocationS3RegionConfigBase(rName),
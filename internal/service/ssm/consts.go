@@ -10,3 +10,39 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	resourceDataSyncTypeSyncFromSource    = "SyncFromSource"
+	resourceDataSyncTypeSyncToDestination = "SyncToDestination"
+)
+
+func resourceDataSyncType_Values() []string {
+	return []string{
+		resourceDataSyncTypeSyncFromSource,
+		resourceDataSyncTypeSyncToDestination,
+	}
+}
+
+const (
+	resourceDataSyncSourceTypeAWSOrganizations          = "AWS:Organizations"
+	resourceDataSyncSourceTypeSingleAccountMultiRegions = "SingleAccountMultiRegions"
+)
+
+func resourceDataSyncSourceType_Values() []string {
+	return []string{
+		resourceDataSyncSourceTypeAWSOrganizations,
+		resourceDataSyncSourceTypeSingleAccountMultiRegions,
+	}
+}
+
+const (
+	resourceDataSyncOrganizationSourceTypeEntireOrganization  = "EntireOrganization"
+	resourceDataSyncOrganizationSourceTypeOrganizationalUnits = "OrganizationalUnits"
+)
+
+func resourceDataSyncOrganizationSourceType_Values() []string {
+	return []string{
+		resourceDataSyncOrganizationSourceTypeEntireOrganization,
+		resourceDataSyncOrganizationSourceTypeOrganizationalUnits,
+	}
+}
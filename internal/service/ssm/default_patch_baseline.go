@@ -132,7 +132,26 @@ func resourceDefaultPatchBaselineRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceDefaultPatchBaselineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return defaultPatchBaselineRestoreOSDefault(ctx, meta.(*conns.AWSClient).SSMClient(ctx), awstypes.OperatingSystem(d.Id()))
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	output, err := findDefaultPatchBaselineByOperatingSystem(ctx, conn, awstypes.OperatingSystem(d.Id()))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Default Patch Baseline (%s): %s", d.Id(), err)
+	}
+
+	// Don't override a default patch baseline that has been registered outside of Terraform.
+	if baselineID := d.Get("baseline_id").(string); !diffSuppressPatchBaselineID("", baselineID, aws.ToString(output.BaselineId), d) {
+		log.Printf("[WARN] SSM Default Patch Baseline (%s) is now %s, not %s; not restoring the AWS-owned default", d.Id(), aws.ToString(output.BaselineId), baselineID)
+		return diags
+	}
+
+	return defaultPatchBaselineRestoreOSDefault(ctx, conn, awstypes.OperatingSystem(d.Id()))
 }
 
 func defaultPatchBaselineRestoreOSDefault(ctx context.Context, conn *ssm.Client, os awstypes.OperatingSystem) diag.Diagnostics {
@@ -332,6 +332,50 @@ func testAccSSMDefaultPatchBaseline_multiRegion(t *testing.T) {
 	})
 }
 
+func testAccSSMDefaultPatchBaseline_registeredOutsideTerraform(t *testing.T) {
+	ctx := acctest.Context(t)
+	var defaultpatchbaseline ssm.GetDefaultPatchBaselineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_default_patch_baseline.test"
+	baselineResourceName := "aws_ssm_patch_baseline.test"
+	otherBaselineResourceName := "aws_ssm_patch_baseline.updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultPatchBaselineConfig_registeredOutsideTerraform(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName, &defaultpatchbaseline),
+					resource.TestCheckResourceAttrPair(resourceName, "baseline_id", baselineResourceName, names.AttrID),
+					testAccCheckDefaultPatchBaselineRegister(ctx, otherBaselineResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Removing the resource must not override the default registered outside of Terraform.
+			{
+				Config: testAccDefaultPatchBaselineConfig_registeredOutsideTerraform(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultPatchBaselineIs(ctx, otherBaselineResourceName),
+				),
+			},
+			{
+				Config: testAccDefaultPatchBaselineConfig_updated(rName, awstypes.OperatingSystemWindows),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultPatchBaselineExists(ctx, resourceName, &defaultpatchbaseline),
+					resource.TestCheckResourceAttrPair(resourceName, "baseline_id", otherBaselineResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDefaultPatchBaselineDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
@@ -390,6 +434,49 @@ func testAccCheckDefaultPatchBaselineExists(ctx context.Context, n string, v *ss
 	}
 }
 
+// testAccCheckDefaultPatchBaselineRegister registers the patch baseline as the default outside of Terraform.
+func testAccCheckDefaultPatchBaselineRegister(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := &ssm.RegisterDefaultPatchBaselineInput{
+			BaselineId: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.RegisterDefaultPatchBaseline(ctx, input)
+
+		return err
+	}
+}
+
+func testAccCheckDefaultPatchBaselineIs(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindDefaultPatchBaselineByOperatingSystem(ctx, conn, awstypes.OperatingSystem(rs.Primary.Attributes["operating_system"]))
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.BaselineId), rs.Primary.ID; got != want {
+			return fmt.Errorf("SSM Default Patch Baseline is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccDefaultPatchBaselineConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_default_patch_baseline" "test" {
@@ -530,3 +617,34 @@ resource "aws_ssm_patch_baseline" "alternate" {
 `, rName),
 	)
 }
+
+func testAccDefaultPatchBaselineConfig_registeredOutsideTerraform(rName string, withDefault bool) string {
+	config := fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "WINDOWS"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+
+resource "aws_ssm_patch_baseline" "updated" {
+  name             = "%[1]s-updated"
+  operating_system = "WINDOWS"
+
+  approved_patches                  = ["KB123456"]
+  approved_patches_compliance_level = "CRITICAL"
+}
+`, rName)
+
+	if !withDefault {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_ssm_default_patch_baseline" "test" {
+  baseline_id      = aws_ssm_patch_baseline.test.id
+  operating_system = aws_ssm_patch_baseline.test.operating_system
+}
+`)
+}
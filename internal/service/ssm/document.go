@@ -5,9 +5,11 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"slices"
 	"strings"
@@ -27,9 +29,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,11 +53,45 @@ func resourceDocument() *schema.Resource {
 		UpdateWithoutTimeout: resourceDocumentUpdate,
 		DeleteWithoutTimeout: resourceDocumentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"approved_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attachments_content": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hash_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSize: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"attachments_source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -85,8 +124,9 @@ func resourceDocument() *schema.Resource {
 				},
 			},
 			names.AttrContent: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentDocumentContent,
 			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
@@ -100,6 +140,12 @@ func resourceDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
 			"document_format": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -171,15 +217,71 @@ func resourceDocument() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"pending_review_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"platform_types": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"requires": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"review": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DocumentReviewAction](),
+						},
+						names.AttrComment: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"wait_for_approval": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"review_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"share_with_organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -224,7 +326,45 @@ func resourceDocument() *schema.Resource {
 					}
 				}
 
-				if d.HasChange(names.AttrContent) {
+				if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 {
+					if documentType := awstypes.DocumentType(d.Get("document_type").(string)); documentType != awstypes.DocumentTypeChangeTemplate {
+						return fmt.Errorf(`"review" is only supported when "document_type" is %q, got %q`, awstypes.DocumentTypeChangeTemplate, documentType)
+					}
+				}
+
+				// The display name is stored per document version and a new version can only be created from new content.
+				if d.Id() != "" && d.HasChange(names.AttrDisplayName) && !documentContentHasChange(d) {
+					if err := d.ForceNew(names.AttrDisplayName); err != nil {
+						return err
+					}
+				}
+
+				if _, ok := d.GetOk("review"); ok && d.Id() != "" && (documentContentHasChange(d) || d.HasChange("review")) {
+					if err := d.SetNewComputed("approved_version"); err != nil {
+						return err
+					}
+					if err := d.SetNewComputed("pending_review_version"); err != nil {
+						return err
+					}
+					if err := d.SetNewComputed("review_status"); err != nil {
+						return err
+					}
+				}
+
+				if (documentContentHasChange(d) || d.HasChanges("document_format", "document_type")) && d.NewValueKnown(names.AttrContent) {
+					documentFormat, documentType := awstypes.DocumentFormat(d.Get("document_format").(string)), awstypes.DocumentType(d.Get("document_type").(string))
+					if err := validateDocumentContent(documentFormat, documentType, d.Get(names.AttrContent).(string)); err != nil {
+						return fmt.Errorf("invalid %q: %w", names.AttrContent, err)
+					}
+				}
+
+				if d.Id() != "" && (documentContentHasChange(d) || d.HasChange("attachments_source")) {
+					if err := d.SetNewComputed("attachments_content"); err != nil {
+						return err
+					}
+				}
+
+				if documentContentHasChange(d) {
 					if err := d.SetNewComputed("default_version"); err != nil {
 						return err
 					}
@@ -265,6 +405,14 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta an
 		input.Attachments = expandAttachmentsSources(v.([]any))
 	}
 
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requires"); ok && len(v.([]any)) > 0 {
+		input.Requires = expandDocumentRequires(v.([]any))
+	}
+
 	if v, ok := d.GetOk("target_type"); ok {
 		input.TargetType = aws.String(v.(string))
 	}
@@ -301,10 +449,30 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
-	if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+	if d.Get("share_with_organization").(bool) {
+		organizationID, err := findCallerOrganizationID(ctx, meta.(*conns.AWSClient))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Document (%s): %s", d.Id(), err)
+		}
+
+		if err := modifyDocumentPermissions(ctx, conn, d.Id(), []string{organizationID}, nil); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying SSM Document (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if _, err := waitDocumentActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		version := aws.ToString(output.DocumentDescription.DocumentVersion)
+
+		if err := reviewDocument(ctx, conn, d.Id(), version, v.([]any)[0].(map[string]any), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reviewing SSM Document (%s) version (%s): %s", d.Id(), version, err)
+		}
+	}
+
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
 }
 
@@ -325,10 +493,12 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	documentType, name := doc.DocumentType, aws.ToString(doc.Name)
+	d.Set("approved_version", doc.ApprovedVersion)
 	d.Set(names.AttrARN, documentARN(ctx, meta.(*conns.AWSClient), documentType, name))
 	d.Set(names.AttrCreatedDate, aws.ToTime(doc.CreatedDate).Format(time.RFC3339))
 	d.Set("default_version", doc.DefaultVersion)
 	d.Set(names.AttrDescription, doc.Description)
+	d.Set(names.AttrDisplayName, doc.DisplayName)
 	d.Set("document_format", doc.DocumentFormat)
 	d.Set("document_type", documentType)
 	d.Set("document_version", doc.DocumentVersion)
@@ -340,7 +510,12 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set(names.AttrParameter, flattenDocumentParameters(doc.Parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
+	d.Set("pending_review_version", doc.PendingReviewVersion)
 	d.Set("platform_types", doc.PlatformTypes)
+	if err := d.Set("requires", flattenDocumentRequires(doc.Requires)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting requires: %s", err)
+	}
+	d.Set("review_status", doc.ReviewStatus)
 	d.Set("schema_version", doc.SchemaVersion)
 	d.Set(names.AttrStatus, doc.Status)
 	d.Set("target_type", doc.TargetType)
@@ -359,6 +534,9 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) content: %s", d.Id(), err)
 		}
 
+		if err := d.Set("attachments_content", flattenAttachmentsContent(output.AttachmentsContent)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting attachments_content: %s", err)
+		}
 		d.Set(names.AttrContent, output.Content)
 	}
 
@@ -374,7 +552,29 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta any)
 			return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) permissions: %s", d.Id(), err)
 		}
 
-		if accountsIDs := output.AccountIds; len(accountsIDs) > 0 {
+		accountsIDs, shareWithOrganization := output.AccountIds, false
+
+		// Only report the organization share separately if it is managed via share_with_organization.
+		if d.Get("share_with_organization").(bool) {
+			organizationID, err := findCallerOrganizationID(ctx, meta.(*conns.AWSClient))
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading SSM Document (%s) permissions: %s", d.Id(), err)
+			default:
+				if slices.Contains(accountsIDs, organizationID) {
+					accountsIDs = slices.DeleteFunc(slices.Clone(accountsIDs), func(v string) bool {
+						return v == organizationID
+					})
+					shareWithOrganization = true
+				}
+			}
+		}
+
+		d.Set("share_with_organization", shareWithOrganization)
+
+		if len(accountsIDs) > 0 {
 			d.Set(names.AttrPermissions, map[string]any{
 				"account_ids":  strings.Join(accountsIDs, ","),
 				names.AttrType: awstypes.DocumentPermissionTypeShare,
@@ -442,7 +642,29 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("share_with_organization") {
+		organizationID, err := findCallerOrganizationID(ctx, meta.(*conns.AWSClient))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
+		}
+
+		var add, remove []string
+		if d.Get("share_with_organization").(bool) {
+			add = []string{organizationID}
+		} else if !slices.Contains(documentPermissionsAccountIDs(d.Get(names.AttrPermissions).(map[string]any)), organizationID) {
+			// Don't stop sharing with the organization if its ID has just been added to permissions.
+			remove = []string{organizationID}
+		}
+
+		if err := modifyDocumentPermissions(ctx, conn, d.Id(), add, remove); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying SSM Document (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	version := d.Get("default_version").(string)
+
+	if d.HasChangesExcept(names.AttrPermissions, "review", "share_with_organization", names.AttrTags, names.AttrTagsAll) {
 		// Update for schema version 1.x is not allowed.
 		isSchemaVersion1, _ := regexp.MatchString(`^1[.][0-9]$`, d.Get("schema_version").(string))
 
@@ -458,6 +680,10 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				input.Attachments = expandAttachmentsSources(v.([]any))
 			}
 
+			if v, ok := d.GetOk(names.AttrDisplayName); ok {
+				input.DisplayName = aws.String(v.(string))
+			}
+
 			if v, ok := d.GetOk("target_type"); ok {
 				input.TargetType = aws.String(v.(string))
 			}
@@ -466,33 +692,44 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				input.VersionName = aws.String(v.(string))
 			}
 
-			var defaultVersion string
-
 			output, err := conn.UpdateDocument(ctx, input)
 
-			if errs.IsA[*awstypes.DuplicateDocumentContent](err) {
-				defaultVersion = d.Get("latest_version").(string)
+			// A new document version, and so new attachments, can only be created from new content.
+			if errs.IsA[*awstypes.DuplicateDocumentContent](err) && d.HasChange("attachments_source") {
+				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) attachments: %s; change the document content to create a new version with the updated attachments", d.Id(), err)
+			} else if errs.IsA[*awstypes.DuplicateDocumentContent](err) {
+				// No new version was created, so leave the default version as is.
+				version = d.Get("latest_version").(string)
 			} else if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
 			} else {
-				defaultVersion = aws.ToString(output.DocumentDescription.DocumentVersion)
-			}
+				version = aws.ToString(output.DocumentDescription.DocumentVersion)
 
-			_, err = conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
-				DocumentVersion: aws.String(defaultVersion),
-				Name:            aws.String(d.Id()),
-			})
+				_, err = conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+					DocumentVersion: aws.String(version),
+					Name:            aws.String(d.Id()),
+				})
 
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s) default version: %s", d.Id(), err)
+				}
 			}
 
-			if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+			if _, err := waitDocumentActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) update: %s", d.Id(), err)
 			}
 		}
 	}
 
+	// Each new version of the document must be reviewed separately.
+	if d.HasChanges(names.AttrContent, "review") {
+		if v, ok := d.GetOk("review"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			if err := reviewDocument(ctx, conn, d.Id(), version, v.([]any)[0].(map[string]any), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "reviewing SSM Document (%s) version (%s): %s", d.Id(), version, err)
+			}
+		}
+	}
+
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
 }
 
@@ -520,6 +757,18 @@ func resourceDocumentDelete(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.Get("share_with_organization").(bool) {
+		organizationID, err := findCallerOrganizationID(ctx, meta.(*conns.AWSClient))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting SSM Document (%s): %s", d.Id(), err)
+		}
+
+		if err := modifyDocumentPermissions(ctx, conn, d.Id(), nil, []string{organizationID}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying SSM Document (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting SSM Document: %s", d.Id())
 	_, err := conn.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
 		Name: aws.String(d.Get(names.AttrName).(string)),
@@ -533,13 +782,85 @@ func resourceDocumentDelete(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "deleting SSM Document (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitDocumentDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitDocumentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+// reviewDocument submits a review action for the specified document version and, if requested, waits for the version to be approved.
+func reviewDocument(ctx context.Context, conn *ssm.Client, name, version string, tfMap map[string]any, timeout time.Duration) error {
+	reviews := &awstypes.DocumentReviews{
+		Action: awstypes.DocumentReviewAction(tfMap[names.AttrAction].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrComment].(string); ok && v != "" {
+		reviews.Comment = []awstypes.DocumentReviewCommentSource{{
+			Content: aws.String(v),
+			Type:    awstypes.DocumentReviewCommentTypeComment,
+		}}
+	}
+
+	input := &ssm.UpdateDocumentMetadataInput{
+		DocumentReviews: reviews,
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	if _, err := conn.UpdateDocumentMetadata(ctx, input); err != nil {
+		return err
+	}
+
+	if v, ok := tfMap["wait_for_approval"].(bool); ok && v {
+		if _, err := waitDocumentVersionApproved(ctx, conn, name, version, timeout); err != nil {
+			return fmt.Errorf("waiting for approval: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// modifyDocumentPermissions shares the document with, and stops sharing it with, the specified accounts in batches.
+func modifyDocumentPermissions(ctx context.Context, conn *ssm.Client, name string, add, remove []string) error {
+	for chunk := range slices.Chunk(add, documentPermissionsBatchLimit) {
+		input := &ssm.ModifyDocumentPermissionInput{
+			AccountIdsToAdd: chunk,
+			Name:            aws.String(name),
+			PermissionType:  awstypes.DocumentPermissionTypeShare,
+		}
+
+		if _, err := conn.ModifyDocumentPermission(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	for chunk := range slices.Chunk(remove, documentPermissionsBatchLimit) {
+		input := &ssm.ModifyDocumentPermissionInput{
+			AccountIdsToRemove: chunk,
+			Name:               aws.String(name),
+			PermissionType:     awstypes.DocumentPermissionTypeShare,
+		}
+
+		if _, err := conn.ModifyDocumentPermission(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findCallerOrganizationID returns the ID of the AWS Organizations organization that the caller's account belongs to.
+func findCallerOrganizationID(ctx context.Context, c *conns.AWSClient) (string, error) {
+	organization, err := tforganizations.FindOrganization(ctx, c.OrganizationsClient(ctx))
+
+	if err != nil {
+		return "", fmt.Errorf("reading Organizations Organization: %w", err)
+	}
+
+	return aws.ToString(organization.Id), nil
+}
+
 func findDocumentByName(ctx context.Context, conn *ssm.Client, name string) (*awstypes.DocumentDescription, error) {
 	input := &ssm.DescribeDocumentInput{
 		Name: aws.String(name),
@@ -581,10 +902,40 @@ func statusDocument(ctx context.Context, conn *ssm.Client, name string) retry.St
 	}
 }
 
-func waitDocumentActive(ctx context.Context, conn *ssm.Client, name string) (*awstypes.DocumentDescription, error) { //nolint:unparam
-	const (
-		timeout = 2 * time.Minute
-	)
+func statusDocumentVersionReview(ctx context.Context, conn *ssm.Client, name, version string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDocumentVersionByTwoPartKey(ctx, conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ReviewStatus), nil
+	}
+}
+
+func waitDocumentVersionApproved(ctx context.Context, conn *ssm.Client, name, version string, timeout time.Duration) (*awstypes.DocumentDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ReviewStatusNotReviewed, awstypes.ReviewStatusPending),
+		Target:  enum.Slice(awstypes.ReviewStatusApproved),
+		Refresh: statusDocumentVersionReview(ctx, conn, name, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentActive(ctx context.Context, conn *ssm.Client, name string, timeout time.Duration) (*awstypes.DocumentDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DocumentStatusCreating, awstypes.DocumentStatusUpdating),
 		Target:  enum.Slice(awstypes.DocumentStatusActive),
@@ -603,10 +954,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.Client, name string) (*aw
 	return nil, err
 }
 
-func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string) (*awstypes.DocumentDescription, error) {
-	const (
-		timeout = 2 * time.Minute
-	)
+func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string, timeout time.Duration) (*awstypes.DocumentDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DocumentStatusDeleting),
 		Target:  []string{},
@@ -625,6 +973,150 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string) (*a
 	return nil, err
 }
 
+// suppressEquivalentDocumentContent suppresses differences in document content that
+// don't change its meaning, such as whitespace or key order, based on document_format.
+func suppressEquivalentDocumentContent(k, old, new string, d *schema.ResourceData) bool {
+	return documentContentEquivalent(awstypes.DocumentFormat(d.Get("document_format").(string)), old, new)
+}
+
+// documentContentHasChange reports whether the document content has changed, ignoring equivalent JSON or YAML.
+// ResourceDiff.HasChange does not apply the content attribute's DiffSuppressFunc.
+func documentContentHasChange(d *schema.ResourceDiff) bool {
+	if !d.HasChange(names.AttrContent) {
+		return false
+	}
+
+	if !d.NewValueKnown(names.AttrContent) {
+		return true
+	}
+
+	o, n := d.GetChange(names.AttrContent)
+
+	return !documentContentEquivalent(awstypes.DocumentFormat(d.Get("document_format").(string)), o.(string), n.(string))
+}
+
+func documentContentEquivalent(format awstypes.DocumentFormat, s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	switch format {
+	case awstypes.DocumentFormatJson:
+		return verify.JSONStringsEqual(s1, s2)
+	case awstypes.DocumentFormatYaml:
+		var v1, v2 any
+
+		if err := tfyaml.DecodeFromString(s1, &v1); err != nil {
+			return false
+		}
+
+		if err := tfyaml.DecodeFromString(s2, &v2); err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(v1, v2)
+	default:
+		return false
+	}
+}
+
+// validateDocumentContent performs basic structural validation of document content so that
+// malformed documents are reported during plan rather than by the CreateDocument or UpdateDocument API.
+func validateDocumentContent(format awstypes.DocumentFormat, documentType awstypes.DocumentType, content string) error {
+	if content == "" {
+		return nil
+	}
+
+	var document map[string]any
+
+	switch format {
+	case awstypes.DocumentFormatJson:
+		if err := json.Unmarshal([]byte(content), &document); err != nil {
+			return fmt.Errorf("decoding JSON: %w", err)
+		}
+	case awstypes.DocumentFormatYaml:
+		if err := tfyaml.DecodeFromString(content, &document); err != nil {
+			return fmt.Errorf("decoding YAML: %w", err)
+		}
+	default:
+		return nil
+	}
+
+	// Only Automation and Command documents have a well-known structure.
+	if documentType != awstypes.DocumentTypeAutomation && documentType != awstypes.DocumentTypeCommand {
+		return nil
+	}
+
+	v, ok := document["schemaVersion"]
+	if !ok || v == nil {
+		return errors.New("schemaVersion is required")
+	}
+	schemaVersion := fmt.Sprint(v)
+
+	if documentType == awstypes.DocumentTypeAutomation || strings.HasPrefix(schemaVersion, "2.") {
+		if v, ok := document["mainSteps"].([]any); !ok || len(v) == 0 {
+			return fmt.Errorf("mainSteps must contain at least one step for %s documents with schemaVersion %s", documentType, schemaVersion)
+		}
+	}
+
+	if v, ok := document["parameters"]; ok && v != nil {
+		parameters, ok := v.(map[string]any)
+		if !ok {
+			return errors.New("parameters must be an object")
+		}
+
+		for name, v := range parameters {
+			parameter, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("parameter (%s) must be an object", name)
+			}
+
+			typ, ok := parameter[names.AttrType].(string)
+			if !ok || typ == "" {
+				return fmt.Errorf("parameter (%s) type is required", name)
+			}
+
+			if documentType == awstypes.DocumentTypeCommand {
+				if !slices.Contains(commandDocumentParameterTypes(), typ) {
+					return fmt.Errorf("parameter (%s) type must be one of %s, got %q", name, strings.Join(commandDocumentParameterTypes(), ", "), typ)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func commandDocumentParameterTypes() []string {
+	return []string{
+		"Boolean",
+		"Integer",
+		"MapList",
+		"String",
+		"StringList",
+		"StringMap",
+	}
+}
+
+func flattenAttachmentsContent(apiObjects []awstypes.AttachmentContent) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"hash":         aws.ToString(apiObject.Hash),
+			"hash_type":    string(apiObject.HashType),
+			names.AttrName: aws.ToString(apiObject.Name),
+			names.AttrSize: apiObject.Size,
+		})
+	}
+
+	return tfList
+}
+
 func expandAttachmentsSource(tfMap map[string]any) *awstypes.AttachmentsSource {
 	if tfMap == nil {
 		return nil
@@ -673,6 +1165,82 @@ func expandAttachmentsSources(tfList []any) []awstypes.AttachmentsSource {
 	return apiObjects
 }
 
+func expandDocumentRequire(tfMap map[string]any) *awstypes.DocumentRequires {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DocumentRequires{}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDocumentRequires(tfList []any) []awstypes.DocumentRequires {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.DocumentRequires
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandDocumentRequire(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDocumentRequire(apiObject *awstypes.DocumentRequires) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.ToString(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap[names.AttrVersion] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenDocumentRequires(apiObjects []awstypes.DocumentRequires) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenDocumentRequire(&apiObject))
+	}
+
+	return tfList
+}
+
 func flattenDocumentParameter(apiObject *awstypes.DocumentParameter) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -711,6 +1279,14 @@ func flattenDocumentParameters(apiObjects []awstypes.DocumentParameter) []any {
 	return tfList
 }
 
+func documentPermissionsAccountIDs(tfMap map[string]any) []string {
+	if v, ok := tfMap["account_ids"].(string); ok && v != "" {
+		return strings.Split(v, ",")
+	}
+
+	return nil
+}
+
 func documentARN(ctx context.Context, c *conns.AWSClient, documentType awstypes.DocumentType, name string) string {
 	var resource string
 	switch documentType {
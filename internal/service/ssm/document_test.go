@@ -6,11 +6,15 @@ package ssm_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -21,6 +25,170 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDocumentContentEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format   awstypes.DocumentFormat
+		s1, s2   string
+		expected bool
+	}{
+		"JSON identical": {
+			format:   awstypes.DocumentFormatJson,
+			s1:       `{"schemaVersion":"2.2","description":"test"}`,
+			s2:       `{"schemaVersion":"2.2","description":"test"}`,
+			expected: true,
+		},
+		"JSON whitespace and key order": {
+			format:   awstypes.DocumentFormatJson,
+			s1:       `{"schemaVersion":"2.2","description":"test"}`,
+			s2:       "{\n  \"description\": \"test\",\n  \"schemaVersion\": \"2.2\"\n}\n",
+			expected: true,
+		},
+		"JSON different values": {
+			format:   awstypes.DocumentFormatJson,
+			s1:       `{"schemaVersion":"2.2","description":"test"}`,
+			s2:       `{"schemaVersion":"2.2","description":"other"}`,
+			expected: false,
+		},
+		"YAML whitespace and key order": {
+			format:   awstypes.DocumentFormatYaml,
+			s1:       "schemaVersion: '2.2'\ndescription: test\n",
+			s2:       "description:   test\nschemaVersion: \"2.2\"\n\n",
+			expected: true,
+		},
+		"YAML different values": {
+			format:   awstypes.DocumentFormatYaml,
+			s1:       "schemaVersion: '2.2'\ndescription: test\n",
+			s2:       "schemaVersion: '2.2'\ndescription: other\n",
+			expected: false,
+		},
+		"YAML content as JSON": {
+			format:   awstypes.DocumentFormatYaml,
+			s1:       "schemaVersion: '2.2'\ndescription: test\n",
+			s2:       `{"schemaVersion":"2.2","description":"test"}`,
+			expected: true,
+		},
+		"TEXT whitespace": {
+			format:   awstypes.DocumentFormatText,
+			s1:       "echo test",
+			s2:       "echo  test",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfssm.DocumentContentEquivalent(testCase.format, testCase.s1, testCase.s2), testCase.expected; got != want {
+				t.Errorf("DocumentContentEquivalent(%q, %q, %q) = %t, want %t", testCase.format, testCase.s1, testCase.s2, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateDocumentContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format        awstypes.DocumentFormat
+		documentType  awstypes.DocumentType
+		content       string
+		expectedError *regexp.Regexp
+	}{
+		"empty": {
+			format:       awstypes.DocumentFormatJson,
+			documentType: awstypes.DocumentTypeCommand,
+		},
+		"TEXT": {
+			format:       awstypes.DocumentFormatText,
+			documentType: awstypes.DocumentTypeCommand,
+			content:      "echo test",
+		},
+		"JSON invalid": {
+			format:        awstypes.DocumentFormatJson,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       `{"schemaVersion":"2.2",`,
+			expectedError: regexache.MustCompile(`decoding JSON`),
+		},
+		"YAML invalid": {
+			format:        awstypes.DocumentFormatYaml,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       "schemaVersion: '2.2'\nmainSteps: [\n",
+			expectedError: regexache.MustCompile(`decoding YAML`),
+		},
+		"Command schemaVersion 1.2": {
+			format:       awstypes.DocumentFormatJson,
+			documentType: awstypes.DocumentTypeCommand,
+			content:      `{"schemaVersion":"1.2","parameters":{},"runtimeConfig":{"aws:runShellScript":{"properties":[{"id":"0.aws:runShellScript","runCommand":["ifconfig"]}]}}}`,
+		},
+		"Command schemaVersion 2.2": {
+			format:       awstypes.DocumentFormatJson,
+			documentType: awstypes.DocumentTypeCommand,
+			content:      `{"schemaVersion":"2.2","parameters":{"Message":{"type":"String","default":"hello"}},"mainSteps":[{"action":"aws:runShellScript","name":"example","inputs":{"runCommand":["echo {{Message}}"]}}]}`,
+		},
+		"Command missing schemaVersion": {
+			format:        awstypes.DocumentFormatJson,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       `{"mainSteps":[{"action":"aws:runShellScript","name":"example","inputs":{"runCommand":["echo"]}}]}`,
+			expectedError: regexache.MustCompile(`schemaVersion is required`),
+		},
+		"Command empty mainSteps": {
+			format:        awstypes.DocumentFormatJson,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       `{"schemaVersion":"2.2","mainSteps":[]}`,
+			expectedError: regexache.MustCompile(`mainSteps must contain at least one step`),
+		},
+		"Command invalid parameter type": {
+			format:        awstypes.DocumentFormatJson,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       `{"schemaVersion":"2.2","parameters":{"Message":{"type":"Text"}},"mainSteps":[{"action":"aws:runShellScript","name":"example","inputs":{"runCommand":["echo"]}}]}`,
+			expectedError: regexache.MustCompile(`parameter \(Message\) type must be one of`),
+		},
+		"Command missing parameter type": {
+			format:        awstypes.DocumentFormatJson,
+			documentType:  awstypes.DocumentTypeCommand,
+			content:       `{"schemaVersion":"2.2","parameters":{"Message":{"default":"hello"}},"mainSteps":[{"action":"aws:runShellScript","name":"example","inputs":{"runCommand":["echo"]}}]}`,
+			expectedError: regexache.MustCompile(`parameter \(Message\) type is required`),
+		},
+		"Automation YAML": {
+			format:       awstypes.DocumentFormatYaml,
+			documentType: awstypes.DocumentTypeAutomation,
+			content:      "schemaVersion: '0.3'\nparameters:\n  InstanceId:\n    type: AWS::EC2::Instance::Id\nmainSteps:\n  - name: sleep\n    action: aws:sleep\n    inputs:\n      Duration: PT1S\n",
+		},
+		"Automation YAML missing mainSteps": {
+			format:        awstypes.DocumentFormatYaml,
+			documentType:  awstypes.DocumentTypeAutomation,
+			content:       "schemaVersion: '0.3'\ndescription: test\n",
+			expectedError: regexache.MustCompile(`mainSteps must contain at least one step`),
+		},
+		"ApplicationConfiguration": {
+			format:       awstypes.DocumentFormatJson,
+			documentType: awstypes.DocumentTypeApplicationConfiguration,
+			content:      `{"key":"value"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfssm.ValidateDocumentContent(testCase.format, testCase.documentType, testCase.content)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error matching %q, got none", testCase.expectedError)
+			} else if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
 func TestAccSSMDocument_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -56,6 +224,40 @@ func TestAccSSMDocument_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_equivalentContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_basicCompact(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_name(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -159,6 +361,125 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_review(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_review(rName, "Automation.ChangeTemplate", "SendForReview"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pending_review_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "review.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "review.0.action", "SendForReview"),
+					resource.TestCheckResourceAttr(resourceName, "review_status", "PENDING"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"review"},
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_Review_invalidDocumentType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDocumentConfig_review(rName, "Automation", "SendForReview"),
+				ExpectError: regexache.MustCompile(`"review" is only supported when "document_type" is "Automation.ChangeTemplate"`),
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_invalidContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDocumentConfig_invalidContent(rName),
+				ExpectError: regexache.MustCompile(`mainSteps must contain at least one step`),
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_displayName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_displayName(rName, "Display Name 1", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "Display Name 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDocumentConfig_displayName(rName, "Display Name 2", "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "Display Name 2"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "2"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_displayName(rName, "Display Name 3", "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "Display Name 3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -339,6 +660,49 @@ func TestAccSSMDocument_Permission_change(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_Permission_organization(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_organizationPermission(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.type", "Share"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.account_ids", acctest.Ct12Digit),
+					resource.TestCheckResourceAttr(resourceName, "share_with_organization", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The organization share is reported in permissions on import.
+				ImportStateVerifyIgnore: []string{names.AttrPermissions, "share_with_organization"},
+			},
+			{
+				Config: testAccDocumentConfig_organizationPermission(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.type", "Share"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.account_ids", acctest.Ct12Digit),
+					resource.TestCheckResourceAttr(resourceName, "share_with_organization", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_params(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -416,6 +780,8 @@ func TestAccSSMDocument_package(t *testing.T) {
 				Config: testAccDocumentConfig_typePackage(rName, rInt1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attachments_content.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "attachments_content.0.hash"),
 					resource.TestCheckResourceAttr(resourceName, "document_type", "Package"),
 				),
 			},
@@ -554,6 +920,37 @@ mainSteps:
 	})
 }
 
+func TestAccSSMDocument_requires(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+	schemaResourceName := "aws_ssm_document.schema"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_requires(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_type", "ApplicationConfiguration"),
+					resource.TestCheckResourceAttr(resourceName, "requires.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "requires.0.name", schemaResourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "requires.0.version", schemaResourceName, "document_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -651,6 +1048,128 @@ DOC
 `, rName)
 }
 
+// testAccDocumentConfig_basicCompact is testAccDocumentConfig_basic with the content reformatted.
+func testAccDocumentConfig_basicCompact(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{"schemaVersion": "1.2", "description": "Check ip configuration of a Linux instance.", "parameters": {},
+  "runtimeConfig": {"aws:runShellScript": {"properties": [{"id": "0.aws:runShellScript", "runCommand": ["ifconfig"]}]}}}
+DOC
+}
+`, rName)
+}
+
+func testAccDocumentConfig_invalidContent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Document without steps"
+    mainSteps     = []
+  })
+}
+`, rName)
+}
+
+func testAccDocumentConfig_requires(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "schema" {
+  name          = "%[1]s-schema"
+  document_type = "ApplicationConfigurationSchema"
+
+  content = jsonencode({
+    "$schema" = "http://json-schema.org/draft-04/schema#"
+    type      = "object"
+    properties = {
+      key = {
+        type = "string"
+      }
+    }
+  })
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "ApplicationConfiguration"
+
+  content = jsonencode({
+    key = "value"
+  })
+
+  requires {
+    name    = aws_ssm_document.schema.name
+    version = aws_ssm_document.schema.document_version
+  }
+}
+`, rName)
+}
+
+func testAccDocumentConfig_review(rName, documentType, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = %[2]q
+  document_format = "YAML"
+
+  review {
+    action  = %[3]q
+    comment = "Please review"
+  }
+
+  content = <<DOC
+description: Change template for Terraform acceptance testing.
+templateInformation: Change template for Terraform acceptance testing.
+schemaVersion: '0.3'
+executableRunBooks:
+  - name: AWS-HelloWorld
+    version: '1'
+mainSteps:
+  - name: SimpleApproveAction
+    action: aws:approve
+    timeoutSeconds: 3600
+    inputs:
+      Message: Change template for Terraform acceptance testing.
+      EnhancedApprovals:
+        Approvers:
+          - approver: ${data.aws_caller_identity.current.arn}
+            type: IamUser
+            minRequiredApprovals: 1
+DOC
+}
+`, rName, documentType, action)
+}
+
+func testAccDocumentConfig_displayName(rName, displayName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  display_name  = %[2]q
+  document_type = "Command"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Sample document %[3]s"
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["echo %[3]s"]
+      }
+    }]
+  })
+}
+`, rName, displayName, description)
+}
+
 func testAccDocumentConfig_basicTargetType(rName, typ string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -881,6 +1400,42 @@ DOC
 `, rName, ids)
 }
 
+func testAccDocumentConfig_organizationPermission(rName string, shareWithOrganization bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  permissions = {
+    type        = "Share"
+    account_ids = "123456789012"
+  }
+
+  share_with_organization = %[2]t
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+}
+`, rName, shareWithOrganization)
+}
+
 func testAccDocumentConfig_param(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
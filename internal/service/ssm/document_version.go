@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	documentVersionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_ssm_document_version", name="Document Version")
+func resourceDocumentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDocumentVersionCreate,
+		ReadWithoutTimeout:   resourceDocumentVersionRead,
+		UpdateWithoutTimeout: resourceDocumentVersionUpdate,
+		DeleteWithoutTimeout: resourceDocumentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("set_as_default", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrContent: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentDocumentContent,
+			},
+			names.AttrCreatedDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.DocumentFormatJson,
+				ValidateDiagFunc: enum.Validate[awstypes.DocumentFormat](),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"set_as_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]{3,128}$`), "must contain only alphanumeric, underscore, hyphen, or period characters"),
+					validation.StringLenBetween(3, 128),
+				),
+			},
+		},
+	}
+}
+
+func resourceDocumentVersionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	name := d.Get("document_name").(string)
+	input := &ssm.UpdateDocumentInput{
+		Content:         aws.String(d.Get(names.AttrContent).(string)),
+		DocumentFormat:  awstypes.DocumentFormat(d.Get("document_format").(string)),
+		DocumentVersion: aws.String("$LATEST"),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		input.VersionName = aws.String(v.(string))
+	}
+
+	output, err := conn.UpdateDocument(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Document Version (%s): %s", name, err)
+	}
+
+	version := aws.ToString(output.DocumentDescription.DocumentVersion)
+	id, err := flex.FlattenResourceId([]string{name, version}, documentVersionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDocumentVersionActive(ctx, conn, name, version, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document Version (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("set_as_default").(bool) {
+		if err := updateDocumentDefaultVersion(ctx, conn, name, version); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting SSM Document Version (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDocumentVersionRead(ctx, d, meta)...)
+}
+
+func resourceDocumentVersionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), documentVersionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name, version := parts[0], parts[1]
+	doc, err := findDocumentVersionByTwoPartKey(ctx, conn, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Document Version %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Document Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCreatedDate, aws.ToTime(doc.CreatedDate).Format(time.RFC3339))
+	d.Set("document_format", doc.DocumentFormat)
+	d.Set("document_name", doc.Name)
+	d.Set("document_version", doc.DocumentVersion)
+	d.Set("hash", doc.Hash)
+	d.Set("is_default_version", aws.ToString(doc.DefaultVersion) == version)
+	d.Set(names.AttrStatus, doc.Status)
+	d.Set("version_name", doc.VersionName)
+
+	input := &ssm.GetDocumentInput{
+		DocumentFormat:  doc.DocumentFormat,
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	output, err := conn.GetDocument(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Document Version (%s) content: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrContent, output.Content)
+
+	return diags
+}
+
+func resourceDocumentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), documentVersionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name, version := parts[0], parts[1]
+
+	// set_as_default is only acted on when it changes to true. A document always has a default version,
+	// so changing it to false leaves the default version as is. The current state is reported by is_default_version.
+	if d.HasChange("set_as_default") && d.Get("set_as_default").(bool) {
+		if err := updateDocumentDefaultVersion(ctx, conn, name, version); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting SSM Document Version (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDocumentVersionRead(ctx, d, meta)...)
+}
+
+func resourceDocumentVersionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), documentVersionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name, version := parts[0], parts[1]
+	doc, err := findDocumentVersionByTwoPartKey(ctx, conn, name, version)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Document Version (%s): %s", d.Id(), err)
+	}
+
+	// The default version of a document cannot be deleted; it is removed along with the document itself.
+	if aws.ToString(doc.DefaultVersion) == version {
+		log.Printf("[WARN] SSM Document Version (%s) is the document's default version, removing from state only", d.Id())
+		return diags
+	}
+
+	log.Printf("[INFO] Deleting SSM Document Version: %s", d.Id())
+	_, err = conn.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidDocument](err, "does not exist") || errs.IsA[*awstypes.InvalidDocumentVersion](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Document Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateDocumentDefaultVersion(ctx context.Context, conn *ssm.Client, name, version string) error {
+	input := &ssm.UpdateDocumentDefaultVersionInput{
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	_, err := conn.UpdateDocumentDefaultVersion(ctx, input)
+
+	return err
+}
+
+func findDocumentVersionByTwoPartKey(ctx context.Context, conn *ssm.Client, name, version string) (*awstypes.DocumentDescription, error) {
+	input := &ssm.DescribeDocumentInput{
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	}
+
+	output, err := conn.DescribeDocument(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidDocument](err, "does not exist") || errs.IsA[*awstypes.InvalidDocumentVersion](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Document == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Document, nil
+}
+
+func statusDocumentVersion(ctx context.Context, conn *ssm.Client, name, version string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDocumentVersionByTwoPartKey(ctx, conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDocumentVersionActive(ctx context.Context, conn *ssm.Client, name, version string, timeout time.Duration) (*awstypes.DocumentDescription, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DocumentStatusCreating, awstypes.DocumentStatusUpdating),
+		Target:  enum.Slice(awstypes.DocumentStatusActive),
+		Refresh: statusDocumentVersion(ctx, conn, name, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusInformation)))
+
+		return output, err
+	}
+
+	return nil, err
+}
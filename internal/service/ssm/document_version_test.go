@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMDocumentVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document_version.test"
+	documentResourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentVersionConfig_basic(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedDate),
+					resource.TestCheckResourceAttr(resourceName, "document_format", "JSON"),
+					resource.TestCheckResourceAttrPair(resourceName, "document_name", documentResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "hash"),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMDocumentVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentVersionConfig_basic(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceDocumentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMDocumentVersion_setAsDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentVersionConfig_basic(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccDocumentVersionConfig_basic(rName, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", acctest.CtTrue),
+				),
+			},
+			{
+				// Changing set_as_default to false leaves the default version in place without a perpetual diff.
+				Config: testAccDocumentVersionConfig_basic(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDocumentVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := tfssm.FindDocumentVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["document_name"], rs.Primary.Attributes["document_version"])
+
+		return err
+	}
+}
+
+func testAccDocumentVersionConfig_basic(rName, versionName string, setAsDefault bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+  version_name  = "v1"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Sample document v1"
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["echo v1"]
+      }
+    }]
+  })
+
+  lifecycle {
+    ignore_changes = [content, version_name]
+  }
+}
+
+resource "aws_ssm_document_version" "test" {
+  document_name  = aws_ssm_document.test.name
+  version_name   = %[2]q
+  set_as_default = %[3]t
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Sample document %[2]s"
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["echo %[2]s"]
+      }
+    }]
+  })
+}
+`, rName, versionName, setAsDefault)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_documents", name="Documents")
+func dataSourceDocuments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDocumentsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDocumentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.SSMClient(ctx)
+
+	input := &ssm.ListDocumentsInput{}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filters = expandDocumentKeyValuesFilters(v.(*schema.Set).List())
+	}
+
+	var output []awstypes.DocumentIdentifier
+
+	pages := ssm.NewListDocumentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Documents: %s", err)
+		}
+
+		output = append(output, page.DocumentIdentifiers...)
+	}
+
+	d.SetId(c.Region(ctx))
+	d.Set(names.AttrARNs, tfslices.ApplyToAll(output, func(v awstypes.DocumentIdentifier) string {
+		return documentIdentifierARN(ctx, c, v)
+	}))
+	d.Set(names.AttrNames, tfslices.ApplyToAll(output, func(v awstypes.DocumentIdentifier) string {
+		return aws.ToString(v.Name)
+	}))
+
+	return diags
+}
+
+// documentIdentifierARN returns the ARN of a listed document.
+// Documents shared from other accounts are listed by ARN and documents owned by Amazon have no account ID in their ARN.
+func documentIdentifierARN(ctx context.Context, c *conns.AWSClient, apiObject awstypes.DocumentIdentifier) string {
+	name, owner := aws.ToString(apiObject.Name), aws.ToString(apiObject.Owner)
+
+	if arn.IsARN(name) {
+		return name
+	}
+
+	v, err := arn.Parse(documentARN(ctx, c, apiObject.DocumentType, name))
+
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case owner == "Amazon" || strings.HasPrefix(name, "AWS-"):
+		v.AccountID = ""
+	case owner != "":
+		v.AccountID = owner
+	}
+
+	return v.String()
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMDocumentsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_documents.test"
+	resourceName := "aws_ssm_document.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentsDataSourceConfig_filterTag(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, names.AttrName),
+				),
+			},
+			{
+				Config: testAccDocumentsDataSourceConfig_filterOwner(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "names.#", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccDocumentsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test1" {
+  name          = "%[1]s-1"
+  document_type = "Command"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Sample document"
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["echo test"]
+      }
+    }]
+  })
+
+  tags = {
+    Name = "%[1]s-1"
+  }
+}
+
+resource "aws_ssm_document" "test2" {
+  name          = "%[1]s-2"
+  document_type = "Command"
+
+  content = jsonencode({
+    schemaVersion = "2.2"
+    description   = "Sample document"
+    mainSteps = [{
+      action = "aws:runShellScript"
+      name   = "runShellScript"
+      inputs = {
+        runCommand = ["echo test"]
+      }
+    }]
+  })
+
+  tags = {
+    Name = "%[1]s-2"
+  }
+}
+`, rName)
+}
+
+func testAccDocumentsDataSourceConfig_filterTag(rName string) string {
+	return acctest.ConfigCompose(testAccDocumentsDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssm_documents" "test" {
+  filter {
+    name   = "Owner"
+    values = ["Self"]
+  }
+
+  filter {
+    name   = "tag:Name"
+    values = ["%[1]s-2"]
+  }
+
+  depends_on = [
+    aws_ssm_document.test1,
+    aws_ssm_document.test2,
+  ]
+}
+`, rName))
+}
+
+func testAccDocumentsDataSourceConfig_filterOwner(rName string) string {
+	return acctest.ConfigCompose(testAccDocumentsDataSourceConfig_base(rName), `
+data "aws_ssm_documents" "test" {
+  filter {
+    name   = "Owner"
+    values = ["Self"]
+  }
+
+  filter {
+    name   = "DocumentType"
+    values = ["Command"]
+  }
+
+  depends_on = [
+    aws_ssm_document.test1,
+    aws_ssm_document.test2,
+  ]
+}
+`)
+}
@@ -9,26 +9,32 @@ var (
 	ResourceAssociation             = resourceAssociation
 	ResourceDefaultPatchBaseline    = resourceDefaultPatchBaseline
 	ResourceDocument                = resourceDocument
+	ResourceDocumentVersion         = resourceDocumentVersion
 	ResourceMaintenanceWindow       = resourceMaintenanceWindow
 	ResourceMaintenanceWindowTarget = resourceMaintenanceWindowTarget
 	ResourceMaintenanceWindowTask   = resourceMaintenanceWindowTask
+	ResourceManagedInstance         = resourceManagedInstance
 	ResourceParameter               = resourceParameter
 	ResourcePatchBaseline           = resourcePatchBaseline
 	ResourcePatchGroup              = resourcePatchGroup
 	ResourceResourceDataSync        = resourceResourceDataSync
 	ResourceServiceSetting          = resourceServiceSetting
 
+	DocumentContentEquivalent                          = documentContentEquivalent
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
 	FindDefaultDefaultPatchBaselineIDByOperatingSystem = findDefaultDefaultPatchBaselineIDByOperatingSystem
 	FindDocumentByName                                 = findDocumentByName
+	FindDocumentVersionByTwoPartKey                    = findDocumentVersionByTwoPartKey
 	FindMaintenanceWindowByID                          = findMaintenanceWindowByID
 	FindMaintenanceWindowTargetByTwoPartKey            = findMaintenanceWindowTargetByTwoPartKey
 	FindMaintenanceWindowTaskByTwoPartKey              = findMaintenanceWindowTaskByTwoPartKey
+	FindManagedInstanceByID                            = findManagedInstanceByID
 	FindParameterByName                                = findParameterByName
 	FindPatchBaselineByID                              = findPatchBaselineByID
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
+	ValidateDocumentContent                            = validateDocumentContent
 )
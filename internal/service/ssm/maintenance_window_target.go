@@ -207,6 +207,14 @@ func resourceMaintenanceWindowTargetDelete(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "deleting SSM Maintenance Window Target (%s): %s", d.Id(), err)
 	}
 
+	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return findMaintenanceWindowTargetByTwoPartKey(ctx, conn, d.Get("window_id").(string), d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Maintenance Window Target (%s) delete: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -504,6 +504,14 @@ func resourceMaintenanceWindowTaskDelete(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "deleting SSM Maintenance Window Task (%s): %s", d.Id(), err)
 	}
 
+	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return findMaintenanceWindowTaskByTwoPartKey(ctx, conn, d.Get("window_id").(string), d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Maintenance Window Task (%s) delete: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
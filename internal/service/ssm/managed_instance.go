@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_managed_instance", name="Managed Instance")
+// @Tags(identifierAttribute="id", resourceType="ManagedInstance")
+// @Testing(tagsTest=false)
+func resourceManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedInstanceCreate,
+		ReadWithoutTimeout:   resourceManagedInstanceRead,
+		UpdateWithoutTimeout: resourceManagedInstanceUpdate,
+		DeleteWithoutTimeout: resourceManagedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^mi-[0-9a-f]{17}$`), "must be a managed instance ID (mi-xxxxxxxxxxxxxxxxx)"),
+			},
+			names.AttrIPAddress: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ping_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceManagedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	// Managed instances are registered by the SSM Agent on the host using an activation.
+	// Adopt the existing registration.
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	instance, err := findManagedInstanceByID(ctx, conn, instanceID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Managed Instance (%s): %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	if v, ok := d.GetOk("iam_role"); ok && v.(string) != aws.ToString(instance.IamRole) {
+		if err := updateManagedInstanceRole(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if err := createTags(ctx, conn, d.Id(), string(awstypes.ResourceTypeForTaggingManagedInstance), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting SSM Managed Instance (%s) tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedInstanceRead(ctx, d, meta)...)
+}
+
+func resourceManagedInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	instance, err := findManagedInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Managed Instance %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Managed Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("activation_id", instance.ActivationId)
+	d.Set("agent_version", instance.AgentVersion)
+	d.Set("computer_name", instance.ComputerName)
+	d.Set("iam_role", instance.IamRole)
+	d.Set(names.AttrInstanceID, instance.InstanceId)
+	d.Set(names.AttrIPAddress, instance.IPAddress)
+	d.Set(names.AttrName, instance.Name)
+	d.Set("ping_status", instance.PingStatus)
+	d.Set("platform_name", instance.PlatformName)
+	d.Set("platform_type", instance.PlatformType)
+	d.Set("platform_version", instance.PlatformVersion)
+	if v := instance.RegistrationDate; v != nil {
+		d.Set("registration_date", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("registration_date", nil)
+	}
+
+	return diags
+}
+
+func resourceManagedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	if d.HasChange("iam_role") {
+		if v, ok := d.GetOk("iam_role"); ok {
+			if err := updateManagedInstanceRole(ctx, conn, d.Id(), v.(string)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceManagedInstanceRead(ctx, d, meta)...)
+}
+
+func resourceManagedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	log.Printf("[DEBUG] Deregistering SSM Managed Instance: %s", d.Id())
+	_, err := conn.DeregisterManagedInstance(ctx, &ssm.DeregisterManagedInstanceInput{
+		InstanceId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.InvalidInstanceId](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deregistering SSM Managed Instance (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateManagedInstanceRole(ctx context.Context, conn *ssm.Client, id, iamRole string) error {
+	input := &ssm.UpdateManagedInstanceRoleInput{
+		IamRole:    aws.String(iamRole),
+		InstanceId: aws.String(id),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.UpdateManagedInstanceRole(ctx, input)
+	}, errCodeValidationException, "Nonexistent role")
+
+	if err != nil {
+		return fmt.Errorf("updating SSM Managed Instance (%s) IAM role: %w", id, err)
+	}
+
+	return nil
+}
+
+func findManagedInstanceByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.InstanceInformation, error) {
+	input := &ssm.DescribeInstanceInformationInput{
+		Filters: []awstypes.InstanceInformationStringFilter{
+			{
+				Key:    aws.String("InstanceIds"),
+				Values: []string{id},
+			},
+		},
+	}
+
+	output, err := findInstanceInformation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.InstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findInstanceInformation(ctx context.Context, conn *ssm.Client, input *ssm.DescribeInstanceInformationInput) (*awstypes.InstanceInformation, error) {
+	output, err := findInstanceInformations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findInstanceInformations(ctx context.Context, conn *ssm.Client, input *ssm.DescribeInstanceInformationInput) ([]awstypes.InstanceInformation, error) {
+	var output []awstypes.InstanceInformation
+
+	pages := ssm.NewDescribeInstanceInformationPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidInstanceId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InstanceInformationList...)
+	}
+
+	return output, nil
+}
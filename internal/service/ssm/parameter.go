@@ -5,7 +5,10 @@ package ssm
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -110,6 +113,12 @@ func resourceParameter() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentParameterPolicies,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tier": {
@@ -156,6 +165,14 @@ func resourceParameter() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+				// Parameter policies are only supported by advanced parameters.
+				if v := diff.Get("policies").(string); v != "" && v != "[]" && awstypes.ParameterTier(diff.Get("tier").(string)) == awstypes.ParameterTierStandard {
+					return fmt.Errorf(`"policies" cannot be specified when "tier" is %q`, awstypes.ParameterTierStandard)
+				}
+
+				return nil
+			},
 			// Prevent the following error during tier update from Advanced to Standard:
 			// ValidationException: This parameter uses the advanced-parameter tier. You can't downgrade a parameter from the advanced-parameter tier to the standard-parameter tier. If necessary, you can delete the advanced parameter and recreate it as a standard parameter.
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta any) bool {
@@ -221,6 +238,10 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta a
 		input.KeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		input.Policies = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("tier"); ok {
 		input.Tier = awstypes.ParameterTier(v.(string))
 	}
@@ -344,6 +365,11 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set("data_type", detail.DataType)
 	d.Set(names.AttrDescription, detail.Description)
 	d.Set(names.AttrKeyID, detail.KeyId)
+	policies, err := flattenParameterInlinePolicies(detail.Policies)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter (%s) policies: %s", d.Id(), err)
+	}
+	d.Set("policies", policies)
 	d.Set("tier", detail.Tier)
 
 	return diags
@@ -393,6 +419,15 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta a
 			input.KeyId = aws.String(d.Get(names.AttrKeyID).(string))
 		}
 
+		if d.HasChange("policies") {
+			// An empty list removes all of the parameter's policies.
+			policies := "[]"
+			if v, ok := d.GetOk("policies"); ok {
+				policies = v.(string)
+			}
+			input.Policies = aws.String(policies)
+		}
+
 		// Retrieve the value set in the config directly to counteract the DiffSuppressFunc above.
 		if v := d.GetRawConfig().GetAttr("tier"); v.IsKnown() && !v.IsNull() {
 			input.Tier = awstypes.ParameterTier(v.AsString())
@@ -502,6 +537,64 @@ func findParametersMetadata(ctx context.Context, conn *ssm.Client, input *ssm.De
 	return output, nil
 }
 
+// flattenParameterInlinePolicies returns the parameter's policies as a JSON array, in the same form as the PutParameter Policies parameter.
+func flattenParameterInlinePolicies(apiObjects []awstypes.ParameterInlinePolicy) (string, error) {
+	if len(apiObjects) == 0 {
+		return "", nil
+	}
+
+	policies := make([]json.RawMessage, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		policies = append(policies, json.RawMessage(aws.ToString(apiObject.PolicyText)))
+	}
+
+	b, err := json.Marshal(policies)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// suppressEquivalentParameterPolicies suppresses differences between JSON arrays of parameter policies
+// that contain the same policies, in any order.
+func suppressEquivalentParameterPolicies(k, old, new string, d *schema.ResourceData) bool {
+	o, err := normalizeParameterPolicies(old)
+	if err != nil {
+		return false
+	}
+
+	n, err := normalizeParameterPolicies(new)
+	if err != nil {
+		return false
+	}
+
+	return slices.Equal(o, n)
+}
+
+func normalizeParameterPolicies(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var policies []any
+	if err := json.Unmarshal([]byte(s), &policies); err != nil {
+		return nil, err
+	}
+
+	normalized := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		b, err := json.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, string(b))
+	}
+	slices.Sort(normalized)
+
+	return normalized, nil
+}
+
 func shouldUpdateParameter(d *schema.ResourceData) bool {
 	// If the user has specified a preference, return their preference.
 	if v := d.GetRawConfig().GetAttr("overwrite"); v.IsKnown() && !v.IsNull() {
@@ -73,6 +73,29 @@ func TestAccSSMParameterDataSource_fullPath(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameterDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "data.aws_ssm_parameter.test"
+	name := sdkacctest.RandomWithPrefix("/tf-acc-test/tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataSourceConfig_arn(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, "aws_ssm_parameter.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, "aws_ssm_parameter.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "String"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "TestValue"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameterDataSource_insecureValue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var param awstypes.Parameter
@@ -112,6 +135,21 @@ data "aws_ssm_parameter" "test" {
 `, name, withDecryption)
 }
 
+func testAccParameterDataSourceConfig_arn(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  tier  = "Advanced"
+  value = "TestValue"
+}
+
+data "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.arn
+}
+`, name)
+}
+
 func testAccParameterConfig_insecureValue(rName, pType string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -549,6 +550,49 @@ func TestAccSSMParameter_Tier_intelligentTieringOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_policies(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param awstypes.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+	expiration := time.Now().UTC().AddDate(0, 1, 0).Format("2006-01-02T15:04:05.000Z")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_policies(rName, string(awstypes.ParameterTierStandard), expiration),
+				ExpectError: regexache.MustCompile(`"policies" cannot be specified when "tier" is "Standard"`),
+			},
+			{
+				Config: testAccParameterConfig_policies(rName, string(awstypes.ParameterTierAdvanced), expiration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexache.MustCompile(`"Type":"Expiration"`)),
+					resource.TestMatchResourceAttr(resourceName, "policies", regexache.MustCompile(`"Type":"NoChangeNotification"`)),
+					resource.TestCheckResourceAttr(resourceName, "tier", string(awstypes.ParameterTierAdvanced)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"has_value_wo"},
+			},
+			{
+				Config: testAccParameterConfig_tier(rName, string(awstypes.ParameterTierAdvanced)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var param awstypes.Parameter
@@ -1518,6 +1562,35 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier)
 }
 
+func testAccParameterConfig_policies(rName, tier, expiration string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([
+    {
+      Type    = "Expiration"
+      Version = "1.0"
+      Attributes = {
+        Timestamp = %[3]q
+      }
+    },
+    {
+      Type    = "NoChangeNotification"
+      Version = "1.0"
+      Attributes = {
+        After = "20"
+        Unit  = "Days"
+      }
+    },
+  ])
+}
+`, rName, tier, expiration)
+}
+
 func testAccParameterConfig_tierWithValue(rName, tier, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
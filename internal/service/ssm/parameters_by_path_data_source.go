@@ -53,6 +53,11 @@ func dataSourceParametersByPath() *schema.Resource {
 				Sensitive: true,
 				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 			"with_decryption": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -98,6 +103,9 @@ func dataSourceParametersReadByPath(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrValues, tfslices.ApplyToAll(output, func(v awstypes.Parameter) string {
 		return aws.ToString(v.Value)
 	}))
+	d.Set("versions", tfslices.ApplyToAll(output, func(v awstypes.Parameter) int64 {
+		return v.Version
+	}))
 
 	return diags
 }
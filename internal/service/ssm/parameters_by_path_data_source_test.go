@@ -31,6 +31,8 @@ func TestAccSSMParametersByPathDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "names.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "types.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0", "1"),
 					resource.TestCheckResourceAttr(resourceName, "with_decryption", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "recursive", acctest.CtFalse),
 				),
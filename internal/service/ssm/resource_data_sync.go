@@ -5,6 +5,8 @@ package ssm
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,11 +14,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,6 +33,7 @@ func resourceResourceDataSync() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceDataSyncCreate,
 		ReadWithoutTimeout:   resourceResourceDataSyncRead,
+		UpdateWithoutTimeout: resourceResourceDataSyncUpdate,
 		DeleteWithoutTimeout: resourceResourceDataSyncDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -42,7 +48,7 @@ func resourceResourceDataSync() *schema.Resource {
 			},
 			"s3_destination": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -79,7 +85,100 @@ func resourceResourceDataSync() *schema.Resource {
 					},
 				},
 			},
+			"sync_source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_organizations_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"organization_source_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(resourceDataSyncOrganizationSourceType_Values(), false),
+									},
+									"organizational_units": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"enable_all_ops_data_sources": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"include_future_regions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(resourceDataSyncSourceType_Values(), false),
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sync_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(resourceDataSyncType_Values(), false),
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				_, hasDestination := d.GetOk("s3_destination")
+				_, hasSource := d.GetOk("sync_source")
+
+				switch syncType := d.Get("sync_type").(string); syncType {
+				case resourceDataSyncTypeSyncFromSource:
+					if hasDestination {
+						return fmt.Errorf(`"s3_destination" cannot be specified when "sync_type" is %q`, syncType)
+					}
+					if !hasSource {
+						return fmt.Errorf(`"sync_source" is required when "sync_type" is %q`, syncType)
+					}
+				default:
+					if !hasDestination {
+						return errors.New(`"s3_destination" is required unless "sync_type" is "SyncFromSource"`)
+					}
+				}
+
+				return nil
+			},
+			// Only syncs of type SyncFromSource can be updated in place.
+			func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				if d.Id() != "" && d.HasChange("sync_source") && d.Get("sync_type").(string) != resourceDataSyncTypeSyncFromSource {
+					return d.ForceNew("sync_source")
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -89,8 +188,19 @@ func resourceResourceDataSyncCreate(ctx context.Context, d *schema.ResourceData,
 
 	name := d.Get(names.AttrName).(string)
 	input := &ssm.CreateResourceDataSyncInput{
-		S3Destination: expandResourceDataSyncS3Destination(d),
-		SyncName:      aws.String(name),
+		SyncName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("s3_destination"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.S3Destination = expandResourceDataSyncS3Destination(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("sync_source"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.SyncSource = expandResourceDataSyncSource(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("sync_type"); ok {
+		input.SyncType = aws.String(v.(string))
 	}
 
 	const (
@@ -129,10 +239,35 @@ func resourceResourceDataSyncRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("s3_destination", flattenResourceDataSyncS3Destination(syncItem.S3Destination)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting s3_destination: %s", err)
 	}
+	if err := d.Set("sync_source", flattenResourceDataSyncSourceWithState(syncItem.SyncSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sync_source: %s", err)
+	}
+	d.Set("sync_type", syncItem.SyncType)
 
 	return diags
 }
 
+func resourceResourceDataSyncUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	if d.HasChange("sync_source") {
+		input := &ssm.UpdateResourceDataSyncInput{
+			SyncName:   aws.String(d.Id()),
+			SyncSource: expandResourceDataSyncSource(d.Get("sync_source").([]any)[0].(map[string]any)),
+			SyncType:   aws.String(d.Get("sync_type").(string)),
+		}
+
+		_, err := conn.UpdateResourceDataSync(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Resource Data Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceDataSyncRead(ctx, d, meta)...)
+}
+
 func resourceResourceDataSyncDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMClient(ctx)
@@ -192,6 +327,10 @@ func findResourceDataSyncs(ctx context.Context, conn *ssm.Client, input *ssm.Lis
 }
 
 func flattenResourceDataSyncS3Destination(apiObject *awstypes.ResourceDataSyncS3Destination) []any {
+	if apiObject == nil {
+		return nil
+	}
+
 	tfMap := make(map[string]any)
 
 	tfMap[names.AttrBucketName] = aws.ToString(apiObject.BucketName)
@@ -207,8 +346,7 @@ func flattenResourceDataSyncS3Destination(apiObject *awstypes.ResourceDataSyncS3
 	return []any{tfMap}
 }
 
-func expandResourceDataSyncS3Destination(d *schema.ResourceData) *awstypes.ResourceDataSyncS3Destination {
-	tfMap := d.Get("s3_destination").([]any)[0].(map[string]any)
+func expandResourceDataSyncS3Destination(tfMap map[string]any) *awstypes.ResourceDataSyncS3Destination {
 	apiObject := &awstypes.ResourceDataSyncS3Destination{
 		BucketName: aws.String(tfMap[names.AttrBucketName].(string)),
 		Region:     aws.String(tfMap[names.AttrRegion].(string)),
@@ -225,3 +363,59 @@ func expandResourceDataSyncS3Destination(d *schema.ResourceData) *awstypes.Resou
 
 	return apiObject
 }
+
+func expandResourceDataSyncSource(tfMap map[string]any) *awstypes.ResourceDataSyncSource {
+	apiObject := &awstypes.ResourceDataSyncSource{
+		EnableAllOpsDataSources: tfMap["enable_all_ops_data_sources"].(bool),
+		IncludeFutureRegions:    tfMap["include_future_regions"].(bool),
+		SourceRegions:           flex.ExpandStringValueSet(tfMap["source_regions"].(*schema.Set)),
+		SourceType:              aws.String(tfMap["source_type"].(string)),
+	}
+
+	if v, ok := tfMap["aws_organizations_source"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AwsOrganizationsSource = expandResourceDataSyncAWSOrganizationsSource(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandResourceDataSyncAWSOrganizationsSource(tfMap map[string]any) *awstypes.ResourceDataSyncAwsOrganizationsSource {
+	apiObject := &awstypes.ResourceDataSyncAwsOrganizationsSource{
+		OrganizationSourceType: aws.String(tfMap["organization_source_type"].(string)),
+	}
+
+	if v, ok := tfMap["organizational_units"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OrganizationalUnits = tfslices.ApplyToAll(flex.ExpandStringValueSet(v), func(v string) awstypes.ResourceDataSyncOrganizationalUnit {
+			return awstypes.ResourceDataSyncOrganizationalUnit{
+				OrganizationalUnitId: aws.String(v),
+			}
+		})
+	}
+
+	return apiObject
+}
+
+func flattenResourceDataSyncSourceWithState(apiObject *awstypes.ResourceDataSyncSourceWithState) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"enable_all_ops_data_sources": apiObject.EnableAllOpsDataSources,
+		"include_future_regions":      apiObject.IncludeFutureRegions,
+		"source_regions":              apiObject.SourceRegions,
+		"source_type":                 aws.ToString(apiObject.SourceType),
+		names.AttrState:               aws.ToString(apiObject.State),
+	}
+
+	if v := apiObject.AwsOrganizationsSource; v != nil {
+		tfMap["aws_organizations_source"] = []any{map[string]any{
+			"organization_source_type": aws.ToString(v.OrganizationSourceType),
+			"organizational_units": tfslices.ApplyToAll(v.OrganizationalUnits, func(v awstypes.ResourceDataSyncOrganizationalUnit) string {
+				return aws.ToString(v.OrganizationalUnitId)
+			}),
+		}}
+	}
+
+	return []any{tfMap}
+}
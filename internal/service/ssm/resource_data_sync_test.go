@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -97,6 +99,68 @@ func TestAccSSMResourceDataSync_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMResourceDataSync_syncFromSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_resource_data_sync.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDataSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDataSyncConfig_syncFromSource(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDataSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.include_future_regions", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.source_regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.source_type", "SingleAccountMultiRegions"),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", "SyncFromSource"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceDataSyncConfig_syncFromSource(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDataSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.include_future_regions", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMResourceDataSync_syncFromSourceWithDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDataSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDataSyncConfig_syncFromSourceWithDestination(rName),
+				ExpectError: regexache.MustCompile(`"s3_destination" cannot be specified when "sync_type" is "SyncFromSource"`),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceDataSyncDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
@@ -254,3 +318,41 @@ resource "aws_ssm_resource_data_sync" "test" {
 }
 `, rInt, rName)
 }
+
+func testAccResourceDataSyncConfig_syncFromSource(rName string, includeFutureRegions bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ssm_resource_data_sync" "test" {
+  name      = %[1]q
+  sync_type = "SyncFromSource"
+
+  sync_source {
+    include_future_regions = %[2]t
+    source_regions         = [data.aws_region.current.region]
+    source_type            = "SingleAccountMultiRegions"
+  }
+}
+`, rName, includeFutureRegions)
+}
+
+func testAccResourceDataSyncConfig_syncFromSourceWithDestination(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ssm_resource_data_sync" "test" {
+  name      = %[1]q
+  sync_type = "SyncFromSource"
+
+  s3_destination {
+    bucket_name = %[1]q
+    region      = data.aws_region.current.region
+  }
+
+  sync_source {
+    source_regions = [data.aws_region.current.region]
+    source_type    = "SingleAccountMultiRegions"
+  }
+}
+`, rName)
+}
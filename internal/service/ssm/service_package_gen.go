@@ -51,6 +51,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Document",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceDocuments,
+			TypeName: "aws_ssm_documents",
+			Name:     "Documents",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceInstances,
 			TypeName: "aws_ssm_instances",
@@ -127,6 +133,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  resourceDocumentVersion,
+			TypeName: "aws_ssm_document_version",
+			Name:     "Document Version",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceMaintenanceWindow,
 			TypeName: "aws_ssm_maintenance_window",
@@ -169,6 +181,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				ImportID:      maintenanceWindowTaskImportID{},
 			},
 		},
+		{
+			Factory:  resourceManagedInstance,
+			TypeName: "aws_ssm_managed_instance",
+			Name:     "Managed Instance",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+				ResourceType:        "ManagedInstance",
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceParameter,
 			TypeName: "aws_ssm_parameter",
@@ -15,15 +15,16 @@ func TestAccSSM_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"DefaultPatchBaseline": {
-			acctest.CtBasic:        testAccSSMDefaultPatchBaseline_basic,
-			acctest.CtDisappears:   testAccSSMDefaultPatchBaseline_disappears,
-			"otherOperatingSystem": testAccSSMDefaultPatchBaseline_otherOperatingSystem,
-			"patchBaselineARN":     testAccSSMDefaultPatchBaseline_patchBaselineARN,
-			"systemDefault":        testAccSSMDefaultPatchBaseline_systemDefault,
-			"update":               testAccSSMDefaultPatchBaseline_update,
-			"deleteDefault":        testAccSSMPatchBaseline_deleteDefault,
-			"multiRegion":          testAccSSMDefaultPatchBaseline_multiRegion,
-			"wrongOperatingSystem": testAccSSMDefaultPatchBaseline_wrongOperatingSystem,
+			acctest.CtBasic:              testAccSSMDefaultPatchBaseline_basic,
+			acctest.CtDisappears:         testAccSSMDefaultPatchBaseline_disappears,
+			"otherOperatingSystem":       testAccSSMDefaultPatchBaseline_otherOperatingSystem,
+			"patchBaselineARN":           testAccSSMDefaultPatchBaseline_patchBaselineARN,
+			"registeredOutsideTerraform": testAccSSMDefaultPatchBaseline_registeredOutsideTerraform,
+			"systemDefault":              testAccSSMDefaultPatchBaseline_systemDefault,
+			"update":                     testAccSSMDefaultPatchBaseline_update,
+			"deleteDefault":              testAccSSMPatchBaseline_deleteDefault,
+			"multiRegion":                testAccSSMDefaultPatchBaseline_multiRegion,
+			"wrongOperatingSystem":       testAccSSMDefaultPatchBaseline_wrongOperatingSystem,
 		},
 		"PatchBaseline": {
 			"deleteDefault": testAccSSMPatchBaseline_deleteDefault,
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -26,6 +28,8 @@ func RegisterSweepers() {
 		F:    sweepDefaultPatchBaselines,
 	})
 
+	awsv2.Register("aws_ssm_document", sweepDocuments)
+
 	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
@@ -113,6 +117,45 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, optFns ...tfres
 	return sdkdiag.DiagnosticsError(diags)
 }
 
+func sweepDocuments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.SSMClient(ctx)
+	input := ssm.ListDocumentsInput{
+		Filters: []awstypes.DocumentKeyValuesFilter{
+			{
+				Key:    aws.String("Owner"),
+				Values: []string{"Self"},
+			},
+		},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := ssm.NewListDocumentsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DocumentIdentifiers {
+			name := aws.ToString(v.Name)
+			if !strings.HasPrefix(name, sweep.ResourcePrefix) {
+				log.Printf("[INFO] Skipping SSM Document: %s", name)
+				continue
+			}
+
+			r := resourceDocument()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set(names.AttrName, name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	return sweepResources, nil
+}
+
 func sweepMaintenanceWindows(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmquicksetup
+
+const (
+	patchPolicyConfigurationType             = "AWSQuickSetupType-PatchPolicy"
+	patchPolicyPatchBaselineUseDefaultCustom = "custom"
+)
+
+const (
+	patchPolicyOperationScan           = "Scan"
+	patchPolicyOperationScanAndInstall = "ScanAndInstall"
+)
+
+func patchPolicyOperation_Values() []string {
+	return []string{
+		patchPolicyOperationScan,
+		patchPolicyOperationScanAndInstall,
+	}
+}
+
+const (
+	patchPolicyRebootOptionNoReboot       = "NoReboot"
+	patchPolicyRebootOptionRebootIfNeeded = "RebootIfNeeded"
+)
+
+func patchPolicyRebootOption_Values() []string {
+	return []string{
+		patchPolicyRebootOptionNoReboot,
+		patchPolicyRebootOptionRebootIfNeeded,
+	}
+}
+
+const (
+	patchPolicyTargetTypeAll            = "*"
+	patchPolicyTargetTypeInstanceIDs    = "InstanceIds"
+	patchPolicyTargetTypeResourceGroups = "ResourceGroups"
+	patchPolicyTargetTypeTags           = "Tags"
+)
+
+func patchPolicyTargetType_Values() []string {
+	return []string{
+		patchPolicyTargetTypeAll,
+		patchPolicyTargetTypeInstanceIDs,
+		patchPolicyTargetTypeResourceGroups,
+		patchPolicyTargetTypeTags,
+	}
+}
@@ -6,6 +6,8 @@ package ssmquicksetup
 // Exports for use in tests only.
 var (
 	ResourceConfigurationManager = newConfigurationManagerResource
+	ResourcePatchPolicy          = resourcePatchPolicy
 
 	FindConfigurationManagerByID = findConfigurationManagerByID
+	FindPatchPolicyByARN         = findPatchPolicyByARN
 )
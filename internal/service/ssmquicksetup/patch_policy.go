@@ -0,0 +1,746 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmquicksetup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmquicksetup/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssmquicksetup_patch_policy", name="Patch Policy")
+// @Tags(identifierAttribute="arn")
+func resourcePatchPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePatchPolicyCreate,
+		ReadWithoutTimeout:   resourcePatchPolicyRead,
+		UpdateWithoutTimeout: resourcePatchPolicyUpdate,
+		DeleteWithoutTimeout: resourcePatchPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			validatePatchPolicyOperation,
+			validatePatchPolicyTarget,
+		),
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attach_instance_profile_policies": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"install_next_interval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"install_schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"local_deployment_administration_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"local_deployment_execution_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 120),
+					validation.StringMatch(regexache.MustCompile(`^[ 0-9A-Za-z._-]+$`), "must contain only alphanumeric characters, spaces, periods, underscores and hyphens"),
+				),
+			},
+			"operation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(patchPolicyOperation_Values(), false),
+			},
+			"output_s3": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucketName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"bucket_region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"patch_baseline": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"baseline_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operating_system": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[ssmtypes.OperatingSystem](),
+						},
+					},
+				},
+			},
+			"patch_baseline_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"rate_control_concurrency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "10%",
+			},
+			"rate_control_error_threshold": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "2%",
+			},
+			"reboot_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      patchPolicyRebootOptionRebootIfNeeded,
+				ValidateFunc: validation.StringInSlice(patchPolicyRebootOption_Values(), false),
+			},
+			"scan_next_interval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"scan_schedule": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTarget: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"instance_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"organizational_units": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      patchPolicyTargetTypeAll,
+							ValidateFunc: validation.StringInSlice(patchPolicyTargetType_Values(), false),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePatchPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.SSMQuickSetupClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	parameters, err := expandPatchPolicyParameters(ctx, c.SSMClient(ctx), d, c.Region(ctx))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Quick Setup Patch Policy (%s): %s", name, err)
+	}
+
+	definition := awstypes.ConfigurationDefinitionInput{
+		Parameters: parameters,
+		Type:       aws.String(patchPolicyConfigurationType),
+	}
+
+	if v, ok := d.GetOk("local_deployment_administration_role_arn"); ok {
+		definition.LocalDeploymentAdministrationRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("local_deployment_execution_role_name"); ok {
+		definition.LocalDeploymentExecutionRoleName = aws.String(v.(string))
+	}
+
+	input := &ssmquicksetup.CreateConfigurationManagerInput{
+		ConfigurationDefinitions: []awstypes.ConfigurationDefinitionInput{definition},
+		Name:                     aws.String(name),
+		Tags:                     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationManager(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Quick Setup Patch Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ManagerArn))
+
+	if _, err := waitPatchPolicyDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Quick Setup Patch Policy (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePatchPolicyRead(ctx, d, meta)...)
+}
+
+func resourcePatchPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMQuickSetupClient(ctx)
+
+	output, definition, err := findPatchPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Quick Setup Patch Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ManagerArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("local_deployment_administration_role_arn", definition.LocalDeploymentAdministrationRoleArn)
+	d.Set("local_deployment_execution_role_name", definition.LocalDeploymentExecutionRoleName)
+	d.Set(names.AttrName, output.Name)
+
+	if err := flattenPatchPolicyParameters(d, definition.Parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourcePatchPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.SSMQuickSetupClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		input := &ssmquicksetup.UpdateConfigurationManagerInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			ManagerArn:  aws.String(d.Id()),
+			Name:        aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err := conn.UpdateConfigurationManager(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrDescription, names.AttrTags, names.AttrTagsAll) {
+		_, definition, err := findPatchPolicyByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+		}
+
+		parameters, err := expandPatchPolicyParameters(ctx, c.SSMClient(ctx), d, c.Region(ctx))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+		}
+
+		// Always send the local deployment roles so that removed values are cleared.
+		input := &ssmquicksetup.UpdateConfigurationDefinitionInput{
+			Id:                                   definition.Id,
+			LocalDeploymentAdministrationRoleArn: aws.String(d.Get("local_deployment_administration_role_arn").(string)),
+			LocalDeploymentExecutionRoleName:     aws.String(d.Get("local_deployment_execution_role_name").(string)),
+			ManagerArn:                           aws.String(d.Id()),
+			Parameters:                           parameters,
+		}
+
+		_, err = conn.UpdateConfigurationDefinition(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPatchPolicyDeployed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSM Quick Setup Patch Policy (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePatchPolicyRead(ctx, d, meta)...)
+}
+
+func resourcePatchPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMQuickSetupClient(ctx)
+
+	log.Printf("[INFO] Deleting SSM Quick Setup Patch Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationManager(ctx, &ssmquicksetup.DeleteConfigurationManagerInput{
+		ManagerArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Quick Setup Patch Policy (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPatchPolicyDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Quick Setup Patch Policy (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func validatePatchPolicyOperation(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("install_schedule") {
+		return nil
+	}
+
+	if d.Get("operation").(string) == patchPolicyOperationScanAndInstall && d.Get("install_schedule").(string) == "" {
+		return fmt.Errorf(`"install_schedule" must be set when "operation" is %q`, patchPolicyOperationScanAndInstall)
+	}
+
+	return nil
+}
+
+func validatePatchPolicyTarget(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrTarget) {
+		return nil
+	}
+
+	tfList := d.Get(names.AttrTarget).([]any)
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	if tfMap["accounts"].(*schema.Set).Len() == 0 && tfMap["organizational_units"].(*schema.Set).Len() == 0 {
+		return errors.New(`one of "target.0.accounts" or "target.0.organizational_units" must be set`)
+	}
+
+	switch tfMap[names.AttrType].(string) {
+	case patchPolicyTargetTypeInstanceIDs:
+		if tfMap["instance_ids"].(*schema.Set).Len() == 0 {
+			return fmt.Errorf(`"target.0.instance_ids" must be set when "target.0.type" is %q`, patchPolicyTargetTypeInstanceIDs)
+		}
+	case patchPolicyTargetTypeResourceGroups:
+		if tfMap["resource_group_name"].(string) == "" {
+			return fmt.Errorf(`"target.0.resource_group_name" must be set when "target.0.type" is %q`, patchPolicyTargetTypeResourceGroups)
+		}
+	case patchPolicyTargetTypeTags:
+		if tfMap["tag_key"].(string) == "" || tfMap["tag_value"].(string) == "" {
+			return fmt.Errorf(`"target.0.tag_key" and "target.0.tag_value" must be set when "target.0.type" is %q`, patchPolicyTargetTypeTags)
+		}
+	}
+
+	return nil
+}
+
+// findPatchPolicyByARN returns the Quick Setup configuration manager and its patch policy configuration definition.
+func findPatchPolicyByARN(ctx context.Context, conn *ssmquicksetup.Client, arn string) (*ssmquicksetup.GetConfigurationManagerOutput, *awstypes.ConfigurationDefinition, error) {
+	input := &ssmquicksetup.GetConfigurationManagerInput{
+		ManagerArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConfigurationManager(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if output == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.ConfigurationDefinitions {
+		if aws.ToString(v.Type) == patchPolicyConfigurationType {
+			return output, &v, nil
+		}
+	}
+
+	return nil, nil, tfresource.NewEmptyResultError(input)
+}
+
+func statusPatchPolicyDeployment(ctx context.Context, conn *ssmquicksetup.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, _, err := findPatchPolicyByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The "Deployment" status summary tracks the configuration manager during create, update and delete.
+		for _, v := range output.StatusSummaries {
+			if v.StatusType == awstypes.StatusTypeDeployment {
+				return output, string(v.Status), nil
+			}
+		}
+
+		return nil, "", nil
+	}
+}
+
+func waitPatchPolicyDeployed(ctx context.Context, conn *ssmquicksetup.Client, arn string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusInitializing, awstypes.StatusDeploying),
+		Target:  enum.Slice(awstypes.StatusSucceeded),
+		Refresh: statusPatchPolicyDeployment(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		setPatchPolicyDeploymentLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPatchPolicyDeleted(ctx context.Context, conn *ssmquicksetup.Client, arn string, timeout time.Duration) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeploying, awstypes.StatusStopping, awstypes.StatusDeleting),
+		Target:  []string{},
+		Refresh: statusPatchPolicyDeployment(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmquicksetup.GetConfigurationManagerOutput); ok {
+		setPatchPolicyDeploymentLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setPatchPolicyDeploymentLastError(err error, output *ssmquicksetup.GetConfigurationManagerOutput) {
+	for _, v := range output.StatusSummaries {
+		if v.StatusType == awstypes.StatusTypeDeployment {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+	}
+}
+
+// patchPolicySelectedPatchBaseline is an entry of the SelectedPatchBaselines parameter, keyed by operating system.
+type patchPolicySelectedPatchBaseline struct {
+	Description string `json:"description"`
+	Disabled    bool   `json:"disabled"`
+	Label       string `json:"label"`
+	Value       string `json:"value"`
+}
+
+// patchPolicyOutputS3Location is the value of the OutputS3Location parameter.
+type patchPolicyOutputS3Location struct {
+	OutputS3BucketName   string `json:"OutputS3BucketName"`
+	OutputS3BucketRegion string `json:"OutputS3BucketRegion"`
+	OutputS3KeyPrefix    string `json:"OutputS3KeyPrefix,omitempty"`
+}
+
+// expandPatchPolicyParameters maps the resource's arguments to the AWSQuickSetupType-PatchPolicy configuration parameters.
+func expandPatchPolicyParameters(ctx context.Context, conn *ssm.Client, d *schema.ResourceData, region string) (map[string]string, error) {
+	parameters := map[string]string{
+		"ConfigurationOptionsPatchOperation":   d.Get("operation").(string),
+		"ConfigurationOptionsScanNextInterval": strconv.FormatBool(d.Get("scan_next_interval").(bool)),
+		"ConfigurationOptionsScanValue":        d.Get("scan_schedule").(string),
+		"IsPolicyAttachAllowed":                strconv.FormatBool(d.Get("attach_instance_profile_policies").(bool)),
+		"OutputLogEnableS3":                    strconv.FormatBool(false),
+		"PatchBaselineRegion":                  region,
+		"PatchBaselineUseDefault":              patchPolicyPatchBaselineUseDefaultCustom,
+		"PatchPolicyName":                      d.Get(names.AttrName).(string),
+		"RateControlConcurrency":               d.Get("rate_control_concurrency").(string),
+		"RateControlErrorThreshold":            d.Get("rate_control_error_threshold").(string),
+		"RebootOption":                         d.Get("reboot_option").(string),
+	}
+
+	if v, ok := d.GetOk("install_schedule"); ok {
+		parameters["ConfigurationOptionsInstallNextInterval"] = strconv.FormatBool(d.Get("install_next_interval").(bool))
+		parameters["ConfigurationOptionsInstallValue"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("patch_baseline_region"); ok {
+		parameters["PatchBaselineRegion"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("output_s3"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		location, err := json.Marshal(patchPolicyOutputS3Location{
+			OutputS3BucketName:   tfMap[names.AttrBucketName].(string),
+			OutputS3BucketRegion: tfMap["bucket_region"].(string),
+			OutputS3KeyPrefix:    tfMap["key_prefix"].(string),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		parameters["OutputLogEnableS3"] = strconv.FormatBool(true)
+		parameters["OutputS3Location"] = string(location)
+	}
+
+	// The console shows each selected baseline's name and description, so look them up rather than requiring them.
+	baselines := make(map[string]patchPolicySelectedPatchBaseline)
+	for _, tfMapRaw := range d.Get("patch_baseline").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]any)
+		baselineID := tfMap["baseline_id"].(string)
+
+		output, err := findPatchBaselineByID(ctx, conn, baselineID)
+		if err != nil {
+			return nil, fmt.Errorf("reading SSM Patch Baseline (%s): %w", baselineID, err)
+		}
+
+		baselines[tfMap["operating_system"].(string)] = patchPolicySelectedPatchBaseline{
+			Description: aws.ToString(output.Description),
+			Label:       aws.ToString(output.Name),
+			Value:       baselineID,
+		}
+	}
+
+	selected, err := json.Marshal(baselines)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters["SelectedPatchBaselines"] = string(selected)
+
+	if v, ok := d.GetOk(names.AttrTarget); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+
+		if v := tfMap["accounts"].(*schema.Set); v.Len() > 0 {
+			parameters["TargetAccounts"] = strings.Join(flex.ExpandStringValueSet(v), ",")
+		}
+
+		if v := tfMap["organizational_units"].(*schema.Set); v.Len() > 0 {
+			parameters["TargetOrganizationalUnits"] = strings.Join(flex.ExpandStringValueSet(v), ",")
+		}
+
+		parameters["TargetRegions"] = strings.Join(flex.ExpandStringValueSet(tfMap["regions"].(*schema.Set)), ",")
+
+		targetType := tfMap[names.AttrType].(string)
+		parameters["TargetType"] = targetType
+
+		switch targetType {
+		case patchPolicyTargetTypeInstanceIDs:
+			parameters["TargetInstances"] = strings.Join(flex.ExpandStringValueSet(tfMap["instance_ids"].(*schema.Set)), ",")
+		case patchPolicyTargetTypeResourceGroups:
+			parameters["ResourceGroupName"] = tfMap["resource_group_name"].(string)
+		case patchPolicyTargetTypeTags:
+			parameters["TargetTagKey"] = tfMap["tag_key"].(string)
+			parameters["TargetTagValue"] = tfMap["tag_value"].(string)
+		}
+	}
+
+	return parameters, nil
+}
+
+func flattenPatchPolicyParameters(d *schema.ResourceData, parameters map[string]string) error {
+	d.Set("attach_instance_profile_policies", parameters["IsPolicyAttachAllowed"] == "true")
+	d.Set("install_next_interval", parameters["ConfigurationOptionsInstallNextInterval"] == "true")
+	d.Set("install_schedule", parameters["ConfigurationOptionsInstallValue"])
+	d.Set("operation", parameters["ConfigurationOptionsPatchOperation"])
+	d.Set("patch_baseline_region", parameters["PatchBaselineRegion"])
+	d.Set("rate_control_concurrency", parameters["RateControlConcurrency"])
+	d.Set("rate_control_error_threshold", parameters["RateControlErrorThreshold"])
+	d.Set("reboot_option", parameters["RebootOption"])
+	d.Set("scan_next_interval", parameters["ConfigurationOptionsScanNextInterval"] == "true")
+	d.Set("scan_schedule", parameters["ConfigurationOptionsScanValue"])
+
+	var outputS3 []any
+	if v := parameters["OutputS3Location"]; parameters["OutputLogEnableS3"] == "true" && v != "" {
+		var location patchPolicyOutputS3Location
+		if err := json.Unmarshal([]byte(v), &location); err != nil {
+			return fmt.Errorf("decoding OutputS3Location: %w", err)
+		}
+
+		outputS3 = []any{map[string]any{
+			names.AttrBucketName: location.OutputS3BucketName,
+			"bucket_region":      location.OutputS3BucketRegion,
+			"key_prefix":         location.OutputS3KeyPrefix,
+		}}
+	}
+	if err := d.Set("output_s3", outputS3); err != nil {
+		return fmt.Errorf("setting output_s3: %w", err)
+	}
+
+	var baselines map[string]patchPolicySelectedPatchBaseline
+	if v := parameters["SelectedPatchBaselines"]; v != "" {
+		if err := json.Unmarshal([]byte(v), &baselines); err != nil {
+			return fmt.Errorf("decoding SelectedPatchBaselines: %w", err)
+		}
+	}
+
+	tfList := make([]any, 0, len(baselines))
+	for k, v := range baselines {
+		if v.Disabled {
+			continue
+		}
+
+		tfList = append(tfList, map[string]any{
+			"baseline_id":      v.Value,
+			"operating_system": k,
+		})
+	}
+	if err := d.Set("patch_baseline", tfList); err != nil {
+		return fmt.Errorf("setting patch_baseline: %w", err)
+	}
+
+	splitList := func(v string) []string {
+		if v == "" {
+			return nil
+		}
+		return strings.Split(v, ",")
+	}
+
+	target := map[string]any{
+		"accounts":             splitList(parameters["TargetAccounts"]),
+		"instance_ids":         splitList(parameters["TargetInstances"]),
+		"organizational_units": splitList(parameters["TargetOrganizationalUnits"]),
+		"regions":              splitList(parameters["TargetRegions"]),
+		"resource_group_name":  parameters["ResourceGroupName"],
+		"tag_key":              parameters["TargetTagKey"],
+		"tag_value":            parameters["TargetTagValue"],
+		names.AttrType:         parameters["TargetType"],
+	}
+	if err := d.Set(names.AttrTarget, []any{target}); err != nil {
+		return fmt.Errorf("setting target: %w", err)
+	}
+
+	return nil
+}
+
+func findPatchBaselineByID(ctx context.Context, conn *ssm.Client, id string) (*ssm.GetPatchBaselineOutput, error) {
+	input := &ssm.GetPatchBaselineInput{
+		BaselineId: aws.String(id),
+	}
+
+	output, err := conn.GetPatchBaseline(ctx, input)
+
+	if errs.IsA[*ssmtypes.DoesNotExistException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
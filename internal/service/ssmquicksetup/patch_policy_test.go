@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmquicksetup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfssmquicksetup "github.com/hashicorp/terraform-provider-aws/internal/service/ssmquicksetup"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMQuickSetupPatchPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ssmquicksetup_patch_policy.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+			testAccConfigurationManagerPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchPolicyConfig_basic(rName, "Scan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "operation", "Scan"),
+					resource.TestCheckResourceAttr(resourceName, "output_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "patch_baseline.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "patch_baseline.*", map[string]string{
+						"operating_system": "AMAZON_LINUX_2023",
+					}),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "target.0.accounts.0"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.type", "*"),
+					resource.TestCheckResourceAttr(resourceName, "rate_control_concurrency", "10%"),
+					resource.TestCheckResourceAttr(resourceName, "rate_control_error_threshold", "2%"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_option", "RebootIfNeeded"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPatchPolicyConfig_basic(rName, "ScanAndInstall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operation", "ScanAndInstall"),
+					resource.TestCheckResourceAttr(resourceName, "install_schedule", "cron(0 2 ? * SAT#1 *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupPatchPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ssmquicksetup_patch_policy.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+			testAccConfigurationManagerPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchPolicyConfig_basic(rName, "Scan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmquicksetup.ResourcePatchPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMQuickSetupPatchPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ssmquicksetup_patch_policy.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMQuickSetupEndpointID)
+			testAccConfigurationManagerPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMQuickSetupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchPolicyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchPolicyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPatchPolicyConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPatchPolicyConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchPolicyExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPatchPolicyDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SSMQuickSetupClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmquicksetup_patch_policy" {
+				continue
+			}
+
+			_, _, err := tfssmquicksetup.FindPatchPolicyByARN(ctx, conn, rs.Primary.ID)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Quick Setup Patch Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPatchPolicyExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMQuickSetupClient(ctx)
+
+		_, _, err := tfssmquicksetup.FindPatchPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPatchPolicyConfig_basic(rName, operation string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+data "aws_ssm_patch_baseline" "test" {
+  owner            = "AWS"
+  default_baseline = true
+  operating_system = "AMAZON_LINUX_2023"
+}
+
+resource "aws_ssmquicksetup_patch_policy" "test" {
+  name          = %[1]q
+  operation     = %[2]q
+  scan_schedule = "cron(0 1 * * ? *)"
+
+  install_schedule = %[2]q == "ScanAndInstall" ? "cron(0 2 ? * SAT#1 *)" : null
+
+  patch_baseline {
+    operating_system = "AMAZON_LINUX_2023"
+    baseline_id      = data.aws_ssm_patch_baseline.test.id
+  }
+
+  target {
+    accounts = [data.aws_caller_identity.current.account_id]
+    regions  = [data.aws_region.current.region]
+  }
+
+  local_deployment_administration_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/AWS-QuickSetup-PatchPolicy-LocalAdministrationRole"
+  local_deployment_execution_role_name     = "AWS-QuickSetup-PatchPolicy-LocalExecutionRole"
+}
+`, rName, operation)
+}
+
+func testAccPatchPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+data "aws_ssm_patch_baseline" "test" {
+  owner            = "AWS"
+  default_baseline = true
+  operating_system = "AMAZON_LINUX_2023"
+}
+
+resource "aws_ssmquicksetup_patch_policy" "test" {
+  name          = %[1]q
+  operation     = "Scan"
+  scan_schedule = "cron(0 1 * * ? *)"
+
+  patch_baseline {
+    operating_system = "AMAZON_LINUX_2023"
+    baseline_id      = data.aws_ssm_patch_baseline.test.id
+  }
+
+  target {
+    accounts = [data.aws_caller_identity.current.account_id]
+    regions  = [data.aws_region.current.region]
+  }
+
+  local_deployment_administration_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/AWS-QuickSetup-PatchPolicy-LocalAdministrationRole"
+  local_deployment_execution_role_name     = "AWS-QuickSetup-PatchPolicy-LocalExecutionRole"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPatchPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+data "aws_ssm_patch_baseline" "test" {
+  owner            = "AWS"
+  default_baseline = true
+  operating_system = "AMAZON_LINUX_2023"
+}
+
+resource "aws_ssmquicksetup_patch_policy" "test" {
+  name          = %[1]q
+  operation     = "Scan"
+  scan_schedule = "cron(0 1 * * ? *)"
+
+  patch_baseline {
+    operating_system = "AMAZON_LINUX_2023"
+    baseline_id      = data.aws_ssm_patch_baseline.test.id
+  }
+
+  target {
+    accounts = [data.aws_caller_identity.current.account_id]
+    regions  = [data.aws_region.current.region]
+  }
+
+  local_deployment_administration_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/AWS-QuickSetup-PatchPolicy-LocalAdministrationRole"
+  local_deployment_execution_role_name     = "AWS-QuickSetup-PatchPolicy-LocalExecutionRole"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
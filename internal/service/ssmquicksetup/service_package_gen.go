@@ -40,7 +40,17 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
-	return []*inttypes.ServicePackageSDKResource{}
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourcePatchPolicy,
+			TypeName: "aws_ssmquicksetup_patch_policy",
+			Name:     "Patch Policy",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceReviewTemplate          = newReviewTemplateResource
+	ResourceWorkload                = newWorkloadResource
+	ResourceWorkloadLensAssociation = newWorkloadLensAssociationResource
+
+	FindReviewTemplateByARN                 = findReviewTemplateByARN
+	FindWorkloadByID                        = findWorkloadByID
+	FindWorkloadLensAssociationByTwoPartKey = findWorkloadLensAssociationByTwoPartKey
+)
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload_lens_association", name="Workload Lens Association")
+func newWorkloadLensAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadLensAssociationResource{}
+
+	return r, nil
+}
+
+const (
+	workloadLensAssociationResourceIDPartCount = 2
+)
+
+type workloadLensAssociationResource struct {
+	framework.ResourceWithModel[workloadLensAssociationResourceModel]
+	framework.WithNoUpdate
+}
+
+func (r *workloadLensAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadLensAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadLensAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, lensAlias := data.WorkloadID.ValueString(), data.LensAlias.ValueString()
+	input := wellarchitected.AssociateLensesInput{
+		LensAliases: []string{lensAlias},
+		WorkloadId:  aws.String(workloadID),
+	}
+
+	_, err := conn.AssociateLenses(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Tool Workload Lens Association (%s,%s)", workloadID, lensAlias), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workloadLensAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadLensAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, lensAlias := data.WorkloadID.ValueString(), data.LensAlias.ValueString()
+	err := findWorkloadLensAssociationByTwoPartKey(ctx, conn, workloadID, lensAlias)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Workload Lens Association (%s,%s)", workloadID, lensAlias), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadLensAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadLensAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, lensAlias := data.WorkloadID.ValueString(), data.LensAlias.ValueString()
+	input := wellarchitected.DisassociateLensesInput{
+		LensAliases: []string{lensAlias},
+		WorkloadId:  aws.String(workloadID),
+	}
+	_, err := conn.DisassociateLenses(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Tool Workload Lens Association (%s,%s)", workloadID, lensAlias), err.Error())
+
+		return
+	}
+}
+
+func (r *workloadLensAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, workloadLensAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workload_id,lens_alias. Got: %q", request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("workload_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("lens_alias"), parts[1])...)
+}
+
+func findWorkloadLensAssociationByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, lensAlias string) error {
+	workload, err := findWorkloadByID(ctx, conn, workloadID)
+
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(workload.Lenses, lensAlias) {
+		return &retry.NotFoundError{}
+	}
+
+	return nil
+}
+
+type workloadLensAssociationResourceModel struct {
+	framework.WithRegionModel
+	LensAlias  types.String `tfsdk:"lens_alias"`
+	WorkloadID types.String `tfsdk:"workload_id"`
+}
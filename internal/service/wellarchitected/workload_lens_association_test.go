@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkloadLensAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload_lens_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadLensAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadLensAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadLensAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lens_alias", "serverless"),
+					resource.TestCheckResourceAttrPair(resourceName, "workload_id", "aws_wellarchitected_workload.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccWorkloadLensAssociationImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "workload_id",
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkloadLensAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload_lens_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadLensAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadLensAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadLensAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceWorkloadLensAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadLensAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload_lens_association" {
+				continue
+			}
+
+			err := tfwellarchitected.FindWorkloadLensAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Tool Workload Lens Association %s,%s still exists", rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadLensAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		return tfwellarchitected.FindWorkloadLensAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"])
+	}
+}
+
+func testAccWorkloadLensAssociationImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"]), nil
+	}
+}
+
+func testAccWorkloadLensAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  name        = %[1]q
+  description = "test"
+  environment = "PREPRODUCTION"
+  lenses      = ["wellarchitected"]
+  aws_regions = [data.aws_region.current.region]
+
+  lifecycle {
+    ignore_changes = [lenses]
+  }
+}
+
+resource "aws_wellarchitected_workload_lens_association" "test" {
+  workload_id = aws_wellarchitected_workload.test.id
+  lens_alias  = "serverless"
+}
+`, rName)
+}
@@ -141,6 +141,20 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidARNOfService validates that a string value is an ARN for the specified service
+// whose resource part starts with the specified resource type prefix, e.g. "role/".
+// An empty resourceTypePrefix accepts any resource.
+func ValidARNOfService(service, resourceTypePrefix string) schema.SchemaValidateFunc {
+	return ValidARNCheck(func(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+		if arn.Service != service {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %q, got %q", k, v, service, arn.Service))
+		} else if !strings.HasPrefix(arn.Resource, resourceTypePrefix) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected resource to start with %q", k, v, resourceTypePrefix))
+		}
+		return ws, errors
+	})
+}
+
 func ValidAccountID(v any, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -200,6 +200,37 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNOfService(t *testing.T) {
+	t.Parallel()
+
+	validate := ValidARNOfService("iam", "role/")
+
+	validNames := []string{
+		"",
+		"arn:aws:iam::123456789012:role/AWSBatchServiceRole",                  // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/AWSBatchServiceRole",     // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/AmazonEC2SpotFleetTaggingRole", // lintignore:AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := validate(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"AWSBatchServiceRole",
+		"arn:aws:iam::123456789012:instance-profile/ecsInstanceRole",     // lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/AWSBatchServiceRole/abc", // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := validate(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 